@@ -65,7 +65,7 @@ var generateCmd = &cobra.Command{
 			}
 
 			for _, target := range targets {
-				emitter, ext, err := resolveEmitter(target.Language)
+				emitter, ext, err := resolveEmitter(target.Language, cfg)
 				if err != nil {
 					return err
 				}
@@ -145,12 +145,18 @@ func parseProgram(filePath string, code string) (*generator.ProgramIR, error) {
 	return ir, nil
 }
 
-func resolveEmitter(language string) (emitters.ProgramEmitter, string, error) {
+func resolveEmitter(language string, cfg *config.ContractorConfig) (emitters.ProgramEmitter, string, error) {
 	switch normalizeLanguage(language) {
 	// case "go", "golang":
 	// 	return golang.NewGoEmitter(), ".go", nil
 	case "typescript", "ts":
-		return typescript.NewTypescriptEmitter(), ".ts", nil
+		emitter := typescript.NewTypescriptEmitter()
+		if cfg.CloudEvents != nil {
+			emitter.CloudEvents = &typescript.CloudEventsOptions{
+				Source: cfg.CloudEvents.Source,
+			}
+		}
+		return emitter, ".ts", nil
 	// case "java":
 	// 	return java.NewJavaEmitter(), ".java", nil
 	// case "kotlin", "kt":
@@ -10,7 +10,16 @@ import (
 	"github.com/smtdfc/contractor/generator"
 )
 
-type TypescriptEmitter struct{}
+type CloudEventsOptions struct {
+	Source string
+}
+
+type TypescriptEmitter struct {
+	CloudEvents     *CloudEventsOptions
+	enumValueKinds  map[string]string
+	errorCodes      map[string]string
+	versionedEvents map[string]bool
+}
 
 var typeMap = map[string]string{
 	"Int":    "number",
@@ -19,6 +28,7 @@ var typeMap = map[string]string{
 	"Bool":   "boolean",
 	"Null":   "null",
 	"Any":    "any",
+	"Array":  "Array",
 }
 
 func (t *TypescriptEmitter) EmitTypeName(ir *generator.TypeIR) (string, exception.IException) {
@@ -69,10 +79,47 @@ func (t *TypescriptEmitter) EmitModel(tmpl *template.Template, ir *generator.Mod
 	var sb strings.Builder
 	var data = map[string]any{
 		"ModelName":         ir.Name,
+		"Doc":               emitDocComment("", hasAnnotation(ir.Annotations, "Deprecated"), ""),
 		"CreateConstructor": ir.IsCreateConstructor,
 		"TypeParams":        ir.TypeParams,
 		"IsGeneric":         len(ir.TypeParams) > 0,
 		"IsMapper":          true,
+		"HasParent":         ir.Extends != nil,
+	}
+
+	typeParamDecls := make([]string, 0, len(ir.TypeParamDecls))
+	for _, param := range ir.TypeParamDecls {
+		decl := param.Name
+		if param.Constraint != nil {
+			constraint, err := t.EmitTypeName(param.Constraint)
+			if err != nil {
+				return "", err
+			}
+			decl += " extends " + constraint
+		}
+		if param.Default != nil {
+			defaultType, err := t.EmitTypeName(param.Default)
+			if err != nil {
+				return "", err
+			}
+			decl += " = " + defaultType
+		}
+		typeParamDecls = append(typeParamDecls, decl)
+	}
+	data["TypeParamDecls"] = strings.Join(typeParamDecls, ", ")
+
+	typeParamMappers := make([]string, 0, len(ir.TypeParams))
+	for _, param := range ir.TypeParams {
+		typeParamMappers = append(typeParamMappers, fmt.Sprintf("map%s: (value: any) => %s = (value: any) => value", param, param))
+	}
+	data["TypeParamMappers"] = typeParamMappers
+
+	if ir.Extends != nil {
+		parentTypeName, err := t.EmitTypeName(ir.Extends)
+		if err != nil {
+			return "", err
+		}
+		data["Parent"] = parentTypeName
 	}
 
 	var fields = []any{}
@@ -89,56 +136,115 @@ func (t *TypescriptEmitter) EmitModel(tmpl *template.Template, ir *generator.Mod
 			"Type":          fieldTypeName,
 			"IsModelType":   field.Type.Kind == generator.TypeKindModel,
 			"ModelTypeName": field.Type.Name,
+			"IsInherited":   field.IsInherited,
+			"Mapper":        emitMapperExpr(field.Type),
+			"MapperArgs":    emitMapperArgs(field.Type),
+			"HasDefault":    field.DefaultValue != nil,
+			"Default":       emitDefaultLiteral(field.DefaultValue, field.Type),
 		})
 
-		isModelType := field.Type.Kind == generator.TypeKindModel
-		isArrayOfModelType := false
-		modelTypeName := field.Type.Name
-		if field.Type.Kind == generator.TypeKindBuiltin && field.Type.Name == "Array" && len(field.Type.Generics) == 1 {
-			genericItem := field.Type.Generics[0]
-			if genericItem != nil && genericItem.Kind == generator.TypeKindModel {
-				isArrayOfModelType = true
-				modelTypeName = genericItem.Name
-			}
+		if fieldValidator := emitFieldValidators(field); fieldValidator != nil {
+			fieldValidators = append(fieldValidators, fieldValidator)
 		}
+	}
+	data["Fields"] = fields
+	data["FieldValidators"] = fieldValidators
 
-		validators := []any{}
-		for _, validator := range field.Validators {
-			args := make([]string, 0, len(validator.Args))
-			for _, arg := range validator.Args {
-				args = append(args, emitValueLiteral(arg))
-			}
+	modelValidators := make([]map[string]any, 0, len(ir.Validators))
+	for _, validator := range ir.Validators {
+		call, key := emitModelValidatorCall(validator)
+		if call == "" {
+			continue
+		}
 
-			validators = append(validators, map[string]any{
-				"Name":               validator.Name,
-				"Args":               args,
-				"IsNestedValidate":   validator.Name == "NestedValidate",
-				"Field":              field.Name,
-				"IsModelType":        isModelType,
-				"IsArrayOfModelType": isArrayOfModelType,
-				"ModelTypeName":      modelTypeName,
+		modelValidators = append(modelValidators, map[string]any{
+			"Call": call,
+			"Key":  strconv.Quote(key),
+		})
+	}
+	data["ModelValidators"] = modelValidators
+
+	if err := tmpl.ExecuteTemplate(&sb, "model.tmpl", data); err != nil {
+		return "", exception.NewEmitException(err.Error(), nil)
+	}
+	return sb.String(), nil
+}
+
+func emitFieldValidators(field *generator.ModelField) map[string]any {
+	isModelType := field.Type.Kind == generator.TypeKindModel
+	isArrayOfModelType := false
+	modelTypeName := field.Type.Name
+	if field.Type.Kind == generator.TypeKindBuiltin && field.Type.Name == "Array" && len(field.Type.Generics) == 1 {
+		genericItem := field.Type.Generics[0]
+		if genericItem != nil && genericItem.Kind == generator.TypeKindModel {
+			isArrayOfModelType = true
+			modelTypeName = genericItem.Name
+		}
+	}
+
+	validators := []any{}
+	presenceValidators := []any{}
+	eachValidators := []any{}
+	for _, validator := range field.Validators {
+		target := field.Type
+		if validator.IsEach && len(field.Type.Generics) == 1 {
+			target = field.Type.Generics[0]
+		}
+
+		args := make([]string, 0, len(validator.Args))
+		for _, arg := range validator.Args {
+			args = append(args, emitTypedValueLiteral(arg, target))
+		}
+
+		condition := emitValidatorCondition(validator.Condition)
+		if field.IsOptional && condition != "" && validator.Name == "NotNull" && !validator.IsCustom {
+			presenceValidators = append(presenceValidators, map[string]any{
+				"Name":      validator.Name,
+				"Args":      args,
+				"Condition": condition,
 			})
+			continue
 		}
 
-		if len(validators) > 0 {
-			fieldValidators = append(fieldValidators, map[string]any{
-				"Field":              field.Name,
-				"IsOptional":         field.IsOptional,
-				"IsModelType":        isModelType,
-				"IsArrayOfModelType": isArrayOfModelType,
-				"ModelTypeName":      modelTypeName,
-				"Validators":         validators,
+		if validator.IsEach {
+			eachValidators = append(eachValidators, map[string]any{
+				"Name":      validator.Name,
+				"Args":      args,
+				"IsCustom":  validator.IsCustom,
+				"Condition": condition,
+				"Target":    "item",
 			})
+			continue
 		}
 
+		validators = append(validators, map[string]any{
+			"Target":             "value",
+			"Condition":          condition,
+			"Name":               validator.Name,
+			"Args":               args,
+			"IsNestedValidate":   validator.Name == "NestedValidate" && !validator.IsCustom,
+			"IsCustom":           validator.IsCustom,
+			"Field":              field.Name,
+			"IsModelType":        isModelType,
+			"IsArrayOfModelType": isArrayOfModelType,
+			"ModelTypeName":      modelTypeName,
+		})
 	}
-	data["Fields"] = fields
-	data["FieldValidators"] = fieldValidators
 
-	if err := tmpl.ExecuteTemplate(&sb, "model.tmpl", data); err != nil {
-		return "", exception.NewEmitException(err.Error(), nil)
+	if len(validators) == 0 && len(presenceValidators) == 0 && len(eachValidators) == 0 {
+		return nil
+	}
+
+	return map[string]any{
+		"Field":              field.Name,
+		"EachValidators":     eachValidators,
+		"PresenceValidators": presenceValidators,
+		"IsOptional":         field.IsOptional,
+		"IsModelType":        isModelType,
+		"IsArrayOfModelType": isArrayOfModelType,
+		"ModelTypeName":      modelTypeName,
+		"Validators":         validators,
 	}
-	return sb.String(), nil
 }
 
 func (t *TypescriptEmitter) EmitEvent(tmpl *template.Template, ir *generator.EventIR) (string, exception.IException) {
@@ -152,14 +258,37 @@ func (t *TypescriptEmitter) EmitEvent(tmpl *template.Template, ir *generator.Eve
 		payloadTypeName = emitted
 	}
 
+	symbol := t.eventSymbol(ir)
 	data := map[string]any{
-		"Name":          ir.Name,
+		"Name":          symbol,
+		"PayloadMapper": emitMapperExpr(ir.PayloadType),
 		"EventName":     ir.EventName,
 		"EventNameLit":  quoteLiteral(&ir.EventName, ir.EventName),
 		"NameLit":       quoteLiteral(&ir.Name, ir.Name),
 		"PayloadType":   payloadTypeName,
-		"PayloadAlias":  ir.Name + "Payload",
-		"MetadataConst": ir.Name,
+		"PayloadAlias":  symbol + "Payload",
+		"MetadataConst": symbol,
+		"Version":       ir.Version,
+		"Doc":           emitDocComment("", hasAnnotation(ir.Annotations, "Deprecated"), ""),
+	}
+
+	if ir.Topic != "" {
+		data["Topic"] = strconv.Quote(ir.Topic)
+	}
+
+	if ir.Key != nil {
+		data["Key"] = strconv.Quote(ir.Key.Name)
+		data["KeyOptional"] = ir.Key.IsOptional
+	}
+
+	headers, err := t.emitHeaderBlock(symbol+"Headers", ir.Headers)
+	if err != nil {
+		return "", err
+	}
+	data["Headers"] = headers
+
+	if t.CloudEvents != nil {
+		data["CloudEvent"] = t.emitCloudEvent(ir, symbol)
 	}
 
 	if err := tmpl.ExecuteTemplate(&sb, "event.tmpl", data); err != nil {
@@ -169,6 +298,96 @@ func (t *TypescriptEmitter) EmitEvent(tmpl *template.Template, ir *generator.Eve
 	return sb.String(), nil
 }
 
+func (t *TypescriptEmitter) emitCloudEvent(ir *generator.EventIR, symbol string) map[string]any {
+	dataSchema := ""
+	if ir.DataSchema != "" {
+		dataSchema = strconv.Quote(ir.DataSchema)
+	}
+
+	decoder := "undefined"
+	if emitMapperExpr(ir.PayloadType) != "" {
+		decoder = fmt.Sprintf("decode%sPayload", symbol)
+	}
+
+	validator := "undefined"
+	if ir.PayloadType != nil && ir.PayloadType.Kind == generator.TypeKindModel {
+		validator = fmt.Sprintf("(data: any) => %s.validate(data)", ir.PayloadType.Name)
+	}
+
+	dataVersion := 0
+	if t.versionedEvents[ir.Name] {
+		dataVersion = ir.Version
+	}
+
+	return map[string]any{
+		"Type":        strconv.Quote(ir.EventName),
+		"Source":      strconv.Quote(t.CloudEvents.Source),
+		"DataSchema":  dataSchema,
+		"DataVersion": dataVersion,
+		"Decoder":     decoder,
+		"Validator":   validator,
+	}
+}
+
+func (t *TypescriptEmitter) EmitEventGroup(tmpl *template.Template, ir *generator.EventGroupIR) (string, exception.IException) {
+	var sb strings.Builder
+
+	versions := make([]map[string]any, 0, len(ir.Versions))
+	steps := make([]map[string]any, 0, len(ir.Versions))
+	for i, event := range ir.Versions {
+		symbol := t.eventSymbol(event)
+		decoder := "data"
+		if emitMapperExpr(event.PayloadType) != "" {
+			decoder = fmt.Sprintf("decode%sPayload(data)", symbol)
+		}
+
+		versions = append(versions, map[string]any{
+			"Version": event.Version,
+			"Symbol":  symbol,
+			"Decoder": decoder,
+		})
+
+		if i == 0 {
+			continue
+		}
+
+		previous := ir.Versions[i-1]
+		steps = append(steps, map[string]any{
+			"From":       previous.Version,
+			"Key":        fmt.Sprintf("v%dToV%d", previous.Version, event.Version),
+			"FromSymbol": t.eventSymbol(previous),
+			"ToSymbol":   symbol,
+		})
+	}
+
+	latest := ir.Versions[len(ir.Versions)-1]
+	data := map[string]any{
+		"Name":              ir.Name,
+		"Latest":            t.eventSymbol(latest),
+		"Versions":          versions,
+		"Steps":             steps,
+		"HasPayloadDecoder": emitMapperExpr(latest.PayloadType) != "",
+		"HasKey":            latest.Key != nil,
+		"HasHeaders":        len(latest.Headers) > 0,
+		"CloudEvents":       t.CloudEvents != nil,
+		"EventName":         latest.EventName,
+	}
+
+	if err := tmpl.ExecuteTemplate(&sb, "event_group.tmpl", data); err != nil {
+		return "", exception.NewEmitException(err.Error(), nil)
+	}
+
+	return sb.String(), nil
+}
+
+func (t *TypescriptEmitter) eventSymbol(ir *generator.EventIR) string {
+	if !t.versionedEvents[ir.Name] {
+		return ir.Name
+	}
+
+	return fmt.Sprintf("%sV%d", ir.Name, ir.Version)
+}
+
 func (t *TypescriptEmitter) EmitError(tmpl *template.Template, ir *generator.ErrorIR) (string, exception.IException) {
 	var sb strings.Builder
 
@@ -178,6 +397,7 @@ func (t *TypescriptEmitter) EmitError(tmpl *template.Template, ir *generator.Err
 		"Message":  strconv.Quote(ir.Message),
 		"HasScope": ir.Scope != nil && strings.TrimSpace(*ir.Scope) != "",
 		"Scope":    quoteLiteral(ir.Scope, ""),
+		"Doc":      emitDocComment("", hasAnnotation(ir.Annotations, "Deprecated"), ""),
 	}
 
 	if err := tmpl.ExecuteTemplate(&sb, "error.tmpl", data); err != nil {
@@ -208,19 +428,89 @@ func (t *TypescriptEmitter) EmitRest(tmpl *template.Template, ir *generator.Rest
 		responseTypeName = emitted
 	}
 
+	if ir.ResponseBodyType == nil && len(ir.Responses) > 0 {
+		bodyTypes := make([]string, 0, len(ir.Responses))
+		seenBodyTypes := make(map[string]struct{}, len(ir.Responses))
+		for _, response := range ir.Responses {
+			emitted, err := t.EmitTypeName(response.Type)
+			if err != nil {
+				return "", err
+			}
+
+			if _, exists := seenBodyTypes[emitted]; exists {
+				continue
+			}
+			seenBodyTypes[emitted] = struct{}{}
+			bodyTypes = append(bodyTypes, emitted)
+		}
+		responseTypeName = strings.Join(bodyTypes, " | ")
+	}
+
 	queryLiterals := make([]string, 0, len(ir.Queries))
 	for _, query := range ir.Queries {
 		queryLiterals = append(queryLiterals, strconv.Quote(query))
 	}
 
 	data := map[string]any{
-		"Name":         ir.Name,
-		"Path":         strconv.Quote(ir.Path),
-		"Method":       strconv.Quote(ir.Method),
-		"Queries":      queryLiterals,
-		"RequestType":  requestTypeName,
-		"ResponseType": responseTypeName,
+		"Name":           ir.Name,
+		"RequestMapper":  emitMapperExpr(ir.RequestBodyType),
+		"ResponseMapper": emitMapperExpr(ir.ResponseBodyType),
+		"Path":           strconv.Quote(ir.Path),
+		"Method":         strconv.Quote(ir.Method),
+		"Queries":        queryLiterals,
+		"RequestType":    requestTypeName,
+		"ResponseType":   responseTypeName,
+		"Doc":            emitDocComment("", hasAnnotation(ir.Annotations, "Deprecated"), ""),
+	}
+
+	pathParams, err := t.emitPathParamsBlock(ir.Name, ir.Path, ir.PathParams)
+	if err != nil {
+		return "", err
+	}
+	data["PathParams"] = pathParams
+
+	queryParams, err := t.emitQueryBlock(ir.Name, ir.QueryParams)
+	if err != nil {
+		return "", err
 	}
+	data["QueryParams"] = queryParams
+
+	headers, err := t.emitHeaderBlock(ir.Name+"Headers", ir.Headers)
+	if err != nil {
+		return "", err
+	}
+	responseHeaders, err := t.emitHeaderBlock(ir.Name+"ResponseHeaders", ir.ResponseHeaders)
+	if err != nil {
+		return "", err
+	}
+	data["Headers"] = headers
+
+	restErrors := make([]map[string]any, 0, len(ir.Errors))
+	for _, name := range ir.Errors {
+		restErrors = append(restErrors, map[string]any{
+			"Name":    name,
+			"Literal": strconv.Quote(name),
+			"Code":    t.errorCodes[name],
+		})
+	}
+	data["Errors"] = restErrors
+
+	responses := make([]map[string]any, 0, len(ir.Responses))
+	for _, response := range ir.Responses {
+		typeName, err := t.EmitTypeName(response.Type)
+		if err != nil {
+			return "", err
+		}
+
+		responses = append(responses, map[string]any{
+			"Status": response.Status,
+			"Type":   typeName,
+			"Mapper": emitMapperExpr(response.Type),
+			"IsNull": response.Type.Kind == generator.TypeKindBuiltin && response.Type.Name == "Null",
+		})
+	}
+	data["Responses"] = responses
+	data["ResponseHeaders"] = responseHeaders
 
 	if err := tmpl.ExecuteTemplate(&sb, "rest.tmpl", data); err != nil {
 		return "", exception.NewEmitException(err.Error(), nil)
@@ -235,8 +525,9 @@ func (t *TypescriptEmitter) EmitEnum(tmpl *template.Template, ir *generator.Enum
 	members := make([]map[string]any, 0, len(ir.Members))
 	for i, member := range ir.Members {
 		members = append(members, map[string]any{
-			"Key":    member,
-			"Value":  member,
+			"Key":    member.Name,
+			"Value":  emitValueLiteral(member.Value),
+			"Doc":    emitDocComment(member.Doc, hasAnnotation(member.Annotations, "Deprecated"), "    "),
 			"IsLast": i == len(ir.Members)-1,
 		})
 	}
@@ -244,6 +535,7 @@ func (t *TypescriptEmitter) EmitEnum(tmpl *template.Template, ir *generator.Enum
 	data := map[string]any{
 		"Name":    ir.Name,
 		"Members": members,
+		"Doc":     emitDocComment("", hasAnnotation(ir.Annotations, "Deprecated"), ""),
 	}
 
 	if err := tmpl.ExecuteTemplate(&sb, "enum.tmpl", data); err != nil {
@@ -253,6 +545,31 @@ func (t *TypescriptEmitter) EmitEnum(tmpl *template.Template, ir *generator.Enum
 	return sb.String(), nil
 }
 
+func (t *TypescriptEmitter) EmitConst(tmpl *template.Template, ir *generator.ConstIR) (string, exception.IException) {
+	var sb strings.Builder
+
+	typeName, err := t.EmitTypeName(ir.Type)
+	if err != nil {
+		return "", err
+	}
+
+	value := *ir.Value
+	value.Ref = ""
+
+	data := map[string]any{
+		"Name":  ir.Name,
+		"Type":  typeName,
+		"Value": emitDefaultLiteral(&value, ir.Type),
+		"Doc":   emitDocComment("", hasAnnotation(ir.Annotations, "Deprecated"), ""),
+	}
+
+	if err := tmpl.ExecuteTemplate(&sb, "const.tmpl", data); err != nil {
+		return "", exception.NewEmitException(err.Error(), nil)
+	}
+
+	return sb.String(), nil
+}
+
 func (t *TypescriptEmitter) Emit(ir *generator.ProgramIR) (string, exception.IException) {
 	var sb strings.Builder
 	tmpl, err := template.ParseFS(templateFiles, "templates/*.tmpl")
@@ -260,9 +577,24 @@ func (t *TypescriptEmitter) Emit(ir *generator.ProgramIR) (string, exception.IEx
 		return "", exception.NewEmitException(err.Error(), nil)
 	}
 
+	t.enumValueKinds = make(map[string]string, len(ir.Enums))
+	for _, enumItem := range ir.Enums {
+		t.enumValueKinds[enumItem.Name] = enumItem.ValueKind
+	}
+
+	t.errorCodes = make(map[string]string, len(ir.Errors))
+	for _, errorIR := range ir.Errors {
+		t.errorCodes[errorIR.Name] = quoteLiteral(errorIR.Code, errorIR.Name)
+	}
+
+	t.versionedEvents = make(map[string]bool, len(ir.EventGroups))
+	for _, group := range ir.EventGroups {
+		t.versionedEvents[group.Name] = true
+	}
+
 	sb.WriteString("// @ts-nocheck\n")
-	sb.WriteString("import { Validator } from \"contractor-ts\";\n\n")
-	sb.WriteString("import type { GeneratedErrorConstructorMap, GeneratedValidationDetails, EventMetadata, EventPayload, RestMetadata, RestRequestBody, RestResponseBody } from \"contractor-ts\";\n\n")
+	sb.WriteString("import { RestStatusError, Validator, buildUrl, createCloudEvent, decodeBoolParam, decodeEnumParam, decodeFloatParam, decodeIntParam, openChannel, openEventStream, readCloudEvent, readHeader } from \"contractor-ts\";\n\n")
+	sb.WriteString("import type { GeneratedErrorConstructorMap, GeneratedValidationDetails, EventMetadata, EventPayload, CloudEvent, CloudEventAttributes, CloudEventOptions, HeaderSource, RestMetadata, RestRequestBody, RestResponseBody, RestTransport, RpcMetadata, ServiceMetadata, StreamMetadata, StreamHandlers, StreamSubscription, ChannelMetadata, ChannelHandlers, Channel } from \"contractor-ts\";\n\n")
 
 	if len(ir.Errors) > 0 {
 		for _, errorIR := range ir.Errors {
@@ -286,7 +618,7 @@ func (t *TypescriptEmitter) Emit(ir *generator.ProgramIR) (string, exception.IEx
 		sb.WriteString("};\n\n")
 	}
 
-	for _, model := range ir.Models {
+	for _, model := range orderModelsByInheritance(ir.Models) {
 		code, err := t.EmitModel(tmpl, model)
 		if err != nil {
 			return "", err
@@ -304,6 +636,15 @@ func (t *TypescriptEmitter) Emit(ir *generator.ProgramIR) (string, exception.IEx
 		sb.WriteString(code)
 	}
 
+	for _, constItem := range ir.Consts {
+		code, err := t.EmitConst(tmpl, constItem)
+		if err != nil {
+			return "", err
+		}
+
+		sb.WriteString(code)
+	}
+
 	for _, eventItem := range ir.Events {
 		code, err := t.EmitEvent(tmpl, eventItem)
 		if err != nil {
@@ -313,6 +654,16 @@ func (t *TypescriptEmitter) Emit(ir *generator.ProgramIR) (string, exception.IEx
 		sb.WriteString(code)
 	}
 
+	for _, group := range ir.EventGroups {
+		code, err := t.EmitEventGroup(tmpl, group)
+		if err != nil {
+			return "", err
+		}
+
+		sb.WriteString(code)
+	}
+
+	restsByName := make(map[string]*generator.RestEndpointIR, len(ir.Rests))
 	for _, rest := range ir.Rests {
 		code, err := t.EmitRest(tmpl, rest)
 		if err != nil {
@@ -320,20 +671,637 @@ func (t *TypescriptEmitter) Emit(ir *generator.ProgramIR) (string, exception.IEx
 		}
 
 		sb.WriteString(code)
+		restsByName[rest.Name] = rest
+	}
+
+	for _, rpc := range ir.Rpcs {
+		code, err := t.EmitRpc(tmpl, rpc)
+		if err != nil {
+			return "", err
+		}
+
+		sb.WriteString(code)
+	}
+
+	for _, stream := range ir.Streams {
+		code, err := t.EmitStream(tmpl, stream)
+		if err != nil {
+			return "", err
+		}
+
+		sb.WriteString(code)
+	}
+
+	for _, channel := range ir.Channels {
+		code, err := t.EmitChannel(tmpl, channel)
+		if err != nil {
+			return "", err
+		}
+
+		sb.WriteString(code)
+	}
+
+	for _, service := range ir.Services {
+		code, err := t.EmitService(tmpl, service, restsByName)
+		if err != nil {
+			return "", err
+		}
+
+		sb.WriteString(code)
+	}
+
+	return sb.String(), nil
+}
+
+func (t *TypescriptEmitter) EmitService(tmpl *template.Template, ir *generator.ServiceIR, rests map[string]*generator.RestEndpointIR) (string, exception.IException) {
+	var sb strings.Builder
+
+	endpoints := make([]map[string]any, 0, len(ir.Endpoints))
+	for _, name := range ir.Endpoints {
+		rest, ok := rests[name]
+		if !ok {
+			return "", exception.NewEmitException(fmt.Sprintf("Service '%s' refers to unknown rest '%s'", ir.Name, name), nil)
+		}
+
+		callFields := make([]string, 0, 4)
+		callOptional := true
+		if len(rest.PathParams) > 0 {
+			callFields = append(callFields, fmt.Sprintf("params: %sPathParams;", rest.Name))
+			callOptional = false
+		}
+		if len(rest.QueryParams) > 0 {
+			required := hasRequiredField(rest.QueryParams)
+			callFields = append(callFields, fmt.Sprintf("query%s: %sQuery;", optionalMark(!required), rest.Name))
+			callOptional = callOptional && !required
+		}
+		if len(rest.Headers) > 0 {
+			required := hasRequiredField(rest.Headers)
+			callFields = append(callFields, fmt.Sprintf("headers%s: %sHeaders;", optionalMark(!required), rest.Name))
+			callOptional = callOptional && !required
+		}
+		hasBody := rest.RequestBodyType != nil && !(rest.RequestBodyType.Kind == generator.TypeKindBuiltin && rest.RequestBodyType.Name == "Null")
+		if hasBody {
+			callFields = append(callFields, fmt.Sprintf("body: %sRequestBody;", rest.Name))
+			callOptional = false
+		}
+
+		resultType := rest.Name + "ResponseBody"
+		decode := fmt.Sprintf("result.body as %sResponseBody", rest.Name)
+		if len(rest.Responses) > 0 {
+			resultType = rest.Name + "Response"
+			decode = fmt.Sprintf("decode%sResponse(result.status, result.body)", rest.Name)
+		} else if emitMapperExpr(rest.ResponseBodyType) != "" {
+			decode = fmt.Sprintf("decode%sResponseBody(result.body)", rest.Name)
+		}
+
+		if len(rest.Errors) > 0 {
+			resultType = fmt.Sprintf("%s | %sError", resultType, rest.Name)
+		}
+
+		path := rest.Name + "RestInfo.path"
+		if len(rest.PathParams) > 0 {
+			path = fmt.Sprintf("build%sPath(call.params)", rest.Name)
+		}
+
+		endpoints = append(endpoints, map[string]any{
+			"Name":         rest.Name,
+			"Method":       lowerFirst(rest.Name),
+			"CallFields":   callFields,
+			"CallOptional": callOptional,
+			"Path":         path,
+			"HasQuery":     len(rest.QueryParams) > 0,
+			"HasHeaders":   len(rest.Headers) > 0,
+			"HasBody":      hasBody,
+			"ResultType":   resultType,
+			"Decode":       decode,
+			"HasErrors":    len(rest.Errors) > 0,
+			"HasResponses": len(rest.Responses) > 0,
+		})
+	}
+
+	rpcs := make([]map[string]any, 0, len(ir.Rpcs))
+	for _, name := range ir.Rpcs {
+		rpcs = append(rpcs, map[string]any{
+			"Name":   name,
+			"Method": lowerFirst(name),
+		})
+	}
+
+	data := map[string]any{
+		"Name":      ir.Name,
+		"BasePath":  strconv.Quote(ir.BasePath),
+		"Endpoints": endpoints,
+		"Rpcs":      rpcs,
+		"Doc":       emitDocComment("", hasAnnotation(ir.Annotations, "Deprecated"), ""),
+	}
+
+	if err := tmpl.ExecuteTemplate(&sb, "service.tmpl", data); err != nil {
+		return "", exception.NewEmitException(err.Error(), nil)
+	}
+
+	return sb.String(), nil
+}
+
+func (t *TypescriptEmitter) EmitRpc(tmpl *template.Template, ir *generator.RpcIR) (string, exception.IException) {
+	var sb strings.Builder
+
+	requestTypeName := ""
+	if ir.RequestType != nil && !(ir.RequestType.Kind == generator.TypeKindBuiltin && ir.RequestType.Name == "Null") {
+		emitted, err := t.EmitTypeName(ir.RequestType)
+		if err != nil {
+			return "", err
+		}
+		requestTypeName = emitted
+	}
+
+	responseTypeName, err := t.EmitTypeName(ir.ResponseType)
+	if err != nil {
+		return "", err
+	}
+
+	fullName := ir.Name
+	if ir.Service != "" {
+		fullName = ir.Service + "." + ir.Name
+	}
+
+	errorLiterals := make([]string, 0, len(ir.Errors))
+	for _, name := range ir.Errors {
+		errorLiterals = append(errorLiterals, strconv.Quote(name))
+	}
+
+	data := map[string]any{
+		"Name":         ir.Name,
+		"FullName":     strconv.Quote(fullName),
+		"Service":      strconv.Quote(ir.Service),
+		"HasService":   ir.Service != "",
+		"RequestType":  requestTypeName,
+		"ResponseType": responseTypeName,
+		"Errors":       errorLiterals,
+		"Doc":          emitDocComment("", hasAnnotation(ir.Annotations, "Deprecated"), ""),
+	}
+
+	if err := tmpl.ExecuteTemplate(&sb, "rpc.tmpl", data); err != nil {
+		return "", exception.NewEmitException(err.Error(), nil)
+	}
+
+	return sb.String(), nil
+}
+
+func (t *TypescriptEmitter) EmitStream(tmpl *template.Template, ir *generator.StreamIR) (string, exception.IException) {
+	var sb strings.Builder
+
+	data, err := t.emitStreamingData(ir.Name, ir.Path, ir.PathParams, ir.QueryParams)
+	if err != nil {
+		return "", err
+	}
+
+	eventType, err := t.EmitTypeName(ir.EventType)
+	if err != nil {
+		return "", err
+	}
+
+	data["EventType"] = eventType
+	data["Mapper"] = emitMapperExpr(ir.EventType)
+	data["Doc"] = emitDocComment("", hasAnnotation(ir.Annotations, "Deprecated"), "")
+
+	if err := tmpl.ExecuteTemplate(&sb, "stream.tmpl", data); err != nil {
+		return "", exception.NewEmitException(err.Error(), nil)
 	}
 
 	return sb.String(), nil
 }
 
+func (t *TypescriptEmitter) EmitChannel(tmpl *template.Template, ir *generator.ChannelIR) (string, exception.IException) {
+	var sb strings.Builder
+
+	data, err := t.emitStreamingData(ir.Name, ir.Path, ir.PathParams, ir.QueryParams)
+	if err != nil {
+		return "", err
+	}
+
+	clientMessages, err := t.emitChannelMessages(ir.ClientMessages)
+	if err != nil {
+		return "", err
+	}
+
+	serverMessages, err := t.emitChannelMessages(ir.ServerMessages)
+	if err != nil {
+		return "", err
+	}
+
+	data["ClientMessages"] = clientMessages
+	data["ServerMessages"] = serverMessages
+	data["Doc"] = emitDocComment("", hasAnnotation(ir.Annotations, "Deprecated"), "")
+
+	if err := tmpl.ExecuteTemplate(&sb, "channel.tmpl", data); err != nil {
+		return "", exception.NewEmitException(err.Error(), nil)
+	}
+
+	return sb.String(), nil
+}
+
+func (t *TypescriptEmitter) emitChannelMessages(messages []*generator.TypeIR) ([]map[string]any, exception.IException) {
+	result := make([]map[string]any, 0, len(messages))
+	for _, message := range messages {
+		typeName, err := t.EmitTypeName(message)
+		if err != nil {
+			return nil, err
+		}
+
+		decoder := "data.data"
+		if mapper := emitMapperExpr(message); mapper != "" {
+			decoder = fmt.Sprintf("(%s)(data.data)", mapper)
+		}
+
+		result = append(result, map[string]any{
+			"Literal": strconv.Quote(message.Name),
+			"Type":    typeName,
+			"Decoder": decoder,
+		})
+	}
+
+	return result, nil
+}
+
+func (t *TypescriptEmitter) emitStreamingData(name string, path string, pathParams []*generator.ModelField, queryParams []*generator.ModelField) (map[string]any, exception.IException) {
+	pathBlock, err := t.emitPathParamsBlock(name, path, pathParams)
+	if err != nil {
+		return nil, err
+	}
+
+	queryBlock, err := t.emitQueryBlock(name, queryParams)
+	if err != nil {
+		return nil, err
+	}
+
+	queryLiterals := make([]string, 0, len(queryParams))
+	for _, param := range queryParams {
+		queryLiterals = append(queryLiterals, strconv.Quote(param.Name))
+	}
+
+	callFields := make([]string, 0, 2)
+	callOptional := true
+	pathExpr := strconv.Quote(path)
+	queryExpr := "undefined"
+	if len(pathParams) > 0 {
+		callFields = append(callFields, fmt.Sprintf("params: %sPathParams;", name))
+		callOptional = false
+		pathExpr = fmt.Sprintf("build%sPath(call.params)", name)
+	}
+	if len(queryParams) > 0 {
+		required := hasRequiredField(queryParams)
+		callFields = append(callFields, fmt.Sprintf("query%s: %sQuery;", optionalMark(!required), name))
+		callOptional = callOptional && !required
+		queryExpr = fmt.Sprintf("call.query ? encode%sQuery(call.query) : undefined", name)
+	}
+
+	return map[string]any{
+		"Name":         name,
+		"Path":         strconv.Quote(path),
+		"Queries":      queryLiterals,
+		"PathParams":   pathBlock,
+		"QueryParams":  queryBlock,
+		"CallFields":   callFields,
+		"CallOptional": callOptional,
+		"PathExpr":     pathExpr,
+		"QueryExpr":    queryExpr,
+	}, nil
+}
+
+func hasRequiredField(fields []*generator.ModelField) bool {
+	for _, field := range fields {
+		if !field.IsOptional {
+			return true
+		}
+	}
+	return false
+}
+
+func optionalMark(optional bool) string {
+	if optional {
+		return "?"
+	}
+	return ""
+}
+
+func lowerFirst(value string) string {
+	if value == "" {
+		return value
+	}
+	return strings.ToLower(value[:1]) + value[1:]
+}
+
 func NewTypescriptEmitter() *TypescriptEmitter {
 	return &TypescriptEmitter{}
 }
 
+func emitMapperExpr(ir *generator.TypeIR) string {
+	if ir == nil {
+		return ""
+	}
+
+	switch ir.Kind {
+	case generator.TypeKindModel:
+		return fmt.Sprintf("(value: any) => %s.fromObject(value%s)", ir.Name, emitMapperArgs(ir))
+	case generator.TypeKindGeneric:
+		return "map" + ir.Name
+	case generator.TypeKindBuiltin:
+		if ir.Name != "Array" || len(ir.Generics) != 1 {
+			return ""
+		}
+
+		itemMapper := emitMapperExpr(ir.Generics[0])
+		if itemMapper == "" {
+			return ""
+		}
+
+		return fmt.Sprintf("(value: any) => Array.isArray(value) ? value.map((item: any) => (%s)(item)) : value", itemMapper)
+	default:
+		return ""
+	}
+}
+
+func emitMapperArgs(ir *generator.TypeIR) string {
+	if ir == nil || ir.Kind != generator.TypeKindModel || len(ir.Generics) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	for _, generic := range ir.Generics {
+		mapper := emitMapperExpr(generic)
+		if mapper == "" {
+			mapper = "undefined"
+		}
+		sb.WriteString(", ")
+		sb.WriteString(mapper)
+	}
+
+	return sb.String()
+}
+
+func orderModelsByInheritance(models []*generator.ModelIR) []*generator.ModelIR {
+	byName := make(map[string]*generator.ModelIR, len(models))
+	for _, model := range models {
+		byName[model.Name] = model
+	}
+
+	ordered := make([]*generator.ModelIR, 0, len(models))
+	visited := make(map[string]bool, len(models))
+
+	var visit func(model *generator.ModelIR)
+	visit = func(model *generator.ModelIR) {
+		if visited[model.Name] {
+			return
+		}
+		visited[model.Name] = true
+
+		if model.Extends != nil {
+			if parent, ok := byName[model.Extends.Name]; ok {
+				visit(parent)
+			}
+		}
+
+		ordered = append(ordered, model)
+	}
+
+	for _, model := range models {
+		visit(model)
+	}
+
+	return ordered
+}
+
+func (t *TypescriptEmitter) emitParamDecoder(typeIR *generator.TypeIR, raw string, name string) string {
+	if typeIR == nil {
+		return raw
+	}
+
+	nameLiteral := strconv.Quote(name)
+	switch typeIR.Kind {
+	case generator.TypeKindBuiltin:
+		switch typeIR.Name {
+		case "Int":
+			return fmt.Sprintf("decodeIntParam(%s, %s)", raw, nameLiteral)
+		case "Float":
+			return fmt.Sprintf("decodeFloatParam(%s, %s)", raw, nameLiteral)
+		case "Bool":
+			return fmt.Sprintf("decodeBoolParam(%s, %s)", raw, nameLiteral)
+		}
+	case generator.TypeKindEnum:
+		if t.enumValueKinds[typeIR.Name] == "Int" {
+			raw = fmt.Sprintf("decodeIntParam(%s, %s)", raw, nameLiteral)
+		}
+		return fmt.Sprintf("decodeEnumParam<%s>(%s, %s, %s)", typeIR.Name, raw, typeIR.Name, nameLiteral)
+	}
+
+	return raw
+}
+
+func (t *TypescriptEmitter) emitPathParamsBlock(name string, path string, params []*generator.ModelField) (map[string]any, exception.IException) {
+	if len(params) == 0 {
+		return nil, nil
+	}
+
+	fields := make([]map[string]any, 0, len(params))
+	validators := make([]any, 0)
+	for _, param := range params {
+		typeName, err := t.EmitTypeName(param.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		fields = append(fields, map[string]any{
+			"Name":    param.Name,
+			"Type":    typeName,
+			"Decoder": t.emitParamDecoder(param.Type, fmt.Sprintf("params[%s]", strconv.Quote(param.Name)), param.Name),
+		})
+
+		if fieldValidator := emitFieldValidators(param); fieldValidator != nil {
+			validators = append(validators, fieldValidator)
+		}
+	}
+
+	return map[string]any{
+		"Name":       name,
+		"Fields":     fields,
+		"Builder":    emitPathBuilder(path, "params"),
+		"Validators": validators,
+	}, nil
+}
+
+func (t *TypescriptEmitter) emitQueryBlock(name string, params []*generator.ModelField) (map[string]any, exception.IException) {
+	if len(params) == 0 {
+		return nil, nil
+	}
+
+	fields := make([]map[string]any, 0, len(params))
+	hasValidation := false
+	for _, param := range params {
+		typeName, err := t.EmitTypeName(param.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		key := strconv.Quote(param.Name)
+		isArray := param.Type.Kind == generator.TypeKindBuiltin && param.Type.Name == "Array" && len(param.Type.Generics) == 1
+		decoder := t.emitParamDecoder(param.Type, fmt.Sprintf("search.get(%s)", key), param.Name)
+		if isArray {
+			decoder = fmt.Sprintf("search.getAll(%s).map((item) => %s)", key, t.emitParamDecoder(param.Type.Generics[0], "item", param.Name))
+		}
+
+		field := map[string]any{
+			"Name":       param.Name,
+			"Key":        key,
+			"Type":       typeName,
+			"IsOptional": param.IsOptional,
+			"IsArray":    isArray,
+			"Decoder":    decoder,
+			"Default":    emitDefaultLiteral(param.DefaultValue, param.Type),
+			"Required":   strconv.Quote(fmt.Sprintf("Query parameter '%s' is required", param.Name)),
+		}
+		if fieldValidator := emitFieldValidators(param); fieldValidator != nil {
+			field["Validator"] = fieldValidator
+			hasValidation = true
+		}
+		if !param.IsOptional {
+			hasValidation = true
+		}
+		fields = append(fields, field)
+	}
+
+	return map[string]any{
+		"Name":          name,
+		"Fields":        fields,
+		"HasValidation": hasValidation,
+	}, nil
+}
+
+func (t *TypescriptEmitter) emitHeaderBlock(typeName string, fields []*generator.ModelField) (map[string]any, exception.IException) {
+	if len(fields) == 0 {
+		return nil, nil
+	}
+
+	headers := make([]map[string]any, 0, len(fields))
+	for _, field := range fields {
+		fieldTypeName, err := t.EmitTypeName(field.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		key := strconv.Quote(field.Name)
+		header := map[string]any{
+			"Key":        key,
+			"Type":       fieldTypeName,
+			"IsOptional": field.IsOptional,
+			"Reader":     fmt.Sprintf("readHeader(headers, %s)", key),
+			"Decoder":    t.emitParamDecoder(field.Type, fmt.Sprintf("readHeader(headers, %s)", key), field.Name),
+			"Default":    emitDefaultLiteral(field.DefaultValue, field.Type),
+			"Required":   strconv.Quote(fmt.Sprintf("Header '%s' is required", field.Name)),
+		}
+		if fieldValidator := emitFieldValidators(field); fieldValidator != nil {
+			header["Validator"] = fieldValidator
+		}
+		headers = append(headers, header)
+	}
+
+	return map[string]any{
+		"TypeName": typeName,
+		"Fields":   headers,
+	}, nil
+}
+
+func emitPathBuilder(path string, source string) string {
+	var sb strings.Builder
+	sb.WriteString("`")
+	for i := 0; i < len(path); i++ {
+		switch path[i] {
+		case '{':
+			end := strings.IndexByte(path[i:], '}')
+			if end < 0 {
+				sb.WriteByte(path[i])
+				continue
+			}
+
+			name := path[i+1 : i+end]
+			sb.WriteString(fmt.Sprintf("${encodeURIComponent(String(%s[%s]))}", source, strconv.Quote(name)))
+			i += end
+		case '`', '\\', '$':
+			sb.WriteByte('\\')
+			sb.WriteByte(path[i])
+		default:
+			sb.WriteByte(path[i])
+		}
+	}
+	sb.WriteString("`")
+	return sb.String()
+}
+
+func emitValidatorCondition(condition *generator.ValidatorCondition) string {
+	if condition == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("data?.[%s] === %s", strconv.Quote(condition.Field), emitDefaultLiteral(condition.Value, condition.Type))
+}
+
+func emitModelValidatorCall(validator *generator.ModelValidator) (string, string) {
+	fieldAccess := func(value *generator.ValueIR) (string, string) {
+		name, _ := value.Value.(string)
+		return fmt.Sprintf("data?.[%s]", strconv.Quote(name)), name
+	}
+	keyAt := func(index int, fallback string) string {
+		if len(validator.Args) > index {
+			if key, ok := validator.Args[index].Value.(string); ok {
+				return key
+			}
+		}
+		return fallback
+	}
+
+	switch validator.Name {
+	case "EqualsField":
+		if len(validator.Args) < 3 {
+			return "", ""
+		}
+
+		field, _ := fieldAccess(validator.Args[0])
+		other, key := fieldAccess(validator.Args[1])
+		return fmt.Sprintf("Validator.EqualsField(%s, %s, %s)", field, other, emitValueLiteral(validator.Args[2])), keyAt(3, key)
+	case "Compare":
+		if len(validator.Args) < 4 {
+			return "", ""
+		}
+
+		field, key := fieldAccess(validator.Args[0])
+		other, _ := fieldAccess(validator.Args[2])
+		operator, _ := validator.Args[1].Value.(string)
+		return fmt.Sprintf("Validator.Compare(%s, %s, %s, %s)", field, strconv.Quote(operator), other, emitValueLiteral(validator.Args[3])), keyAt(4, key)
+	case "OneOf":
+		if len(validator.Args) < 2 {
+			return "", ""
+		}
+
+		names, _ := validator.Args[0].Value.([]*generator.ValueIR)
+		values := make([]string, 0, len(names))
+		for _, name := range names {
+			value, _ := fieldAccess(name)
+			values = append(values, value)
+		}
+		return fmt.Sprintf("Validator.OneOf([%s], %s)", strings.Join(values, ", "), emitValueLiteral(validator.Args[1])), keyAt(2, "_model")
+	default:
+		return "", ""
+	}
+}
+
 func emitValueLiteral(value *generator.ValueIR) string {
 	if value == nil {
 		return "null"
 	}
 
+	if value.Ref != "" {
+		return value.Ref
+	}
+
 	switch value.Kind {
 	case "String":
 		if raw, ok := value.Value.(string); ok {
@@ -367,6 +1335,83 @@ func emitValueLiteral(value *generator.ValueIR) string {
 	}
 }
 
+func emitTypedValueLiteral(value *generator.ValueIR, typeIR *generator.TypeIR) string {
+	if value == nil || value.Ref != "" || typeIR == nil {
+		return emitValueLiteral(value)
+	}
+
+	switch value.Kind {
+	case generator.ValueKindEnumMember:
+		return fmt.Sprintf("%s.%v", typeIR.Name, value.Value)
+	case "Array":
+		rawValues, ok := value.Value.([]*generator.ValueIR)
+		if !ok {
+			return "[]"
+		}
+
+		items := make([]string, 0, len(rawValues))
+		for _, item := range rawValues {
+			items = append(items, emitTypedValueLiteral(item, typeIR))
+		}
+
+		return "[" + strings.Join(items, ", ") + "]"
+	}
+
+	return emitValueLiteral(value)
+}
+
+func emitDefaultLiteral(value *generator.ValueIR, typeIR *generator.TypeIR) string {
+	if value == nil {
+		return "undefined"
+	}
+
+	if value.Ref != "" {
+		return value.Ref
+	}
+
+	if value.Kind == generator.ValueKindEnumMember && typeIR != nil {
+		return fmt.Sprintf("%s.%v", typeIR.Name, value.Value)
+	}
+
+	return emitValueLiteral(value)
+}
+
+func emitDocComment(doc string, deprecated bool, indent string) string {
+	lines := make([]string, 0)
+	if strings.TrimSpace(doc) != "" {
+		lines = append(lines, strings.Split(doc, "\n")...)
+	}
+	if deprecated {
+		lines = append(lines, "@deprecated")
+	}
+
+	if len(lines) == 0 {
+		return ""
+	}
+
+	if len(lines) == 1 {
+		return indent + "/** " + lines[0] + " */\n"
+	}
+
+	var sb strings.Builder
+	sb.WriteString(indent + "/**\n")
+	for _, line := range lines {
+		sb.WriteString(indent + " * " + line + "\n")
+	}
+	sb.WriteString(indent + " */\n")
+	return sb.String()
+}
+
+func hasAnnotation(annotations []*generator.AnnotationIR, name string) bool {
+	for _, annotation := range annotations {
+		if annotation != nil && annotation.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
 func quoteLiteral(value *string, fallback string) string {
 	if value == nil || strings.TrimSpace(*value) == "" {
 		return strconv.Quote(fallback)
@@ -0,0 +1,143 @@
+package typescript
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/smtdfc/contractor/generator"
+	"github.com/smtdfc/contractor/parser"
+)
+
+func emitSource(t *testing.T, code string) string {
+	t.Helper()
+
+	tokens, err := parser.NewLexer("test.contract").Start(code)
+	if err != nil {
+		t.Fatalf("unexpected lexer error: %s", err.GetMsg())
+	}
+
+	ast, err := parser.NewParser("test.contract", tokens).Parse()
+	if err != nil {
+		t.Fatalf("unexpected parser error: %s", err.GetMsg())
+	}
+
+	if err := parser.NewTypeChecker().Check(ast); err != nil {
+		t.Fatalf("unexpected type error: %s", err.GetMsg())
+	}
+
+	ir, err := generator.NewIRGenerator().GenerateProgram(ast)
+	if err != nil {
+		t.Fatalf("unexpected generator error: %s", err.GetMsg())
+	}
+
+	out, err := NewTypescriptEmitter().Emit(ir)
+	if err != nil {
+		t.Fatalf("unexpected emit error: %s", err.GetMsg())
+	}
+	return out
+}
+
+func expectEmitted(t *testing.T, out string, snippets ...string) {
+	t.Helper()
+
+	for _, snippet := range snippets {
+		if !strings.Contains(out, snippet) {
+			t.Errorf("expected output to contain:\n%s\n\ngot:\n%s", snippet, out)
+		}
+	}
+}
+
+const usersContract = `
+model User {
+  id: String
+}
+model Job {
+  id: String
+}
+error UserNotFound {
+  code: "USER_NOT_FOUND"
+  status: 404
+  message: "User not found"
+}
+service Users {
+  basePath: "/users"
+  rest GetUser {
+    method: "GET"
+    path: "/{id}"
+    params {
+      id: String
+    }
+    responseBody: User
+    errors: [UserNotFound]
+  }
+  rest Ping {
+    method: "GET"
+    path: "/ping"
+    responseBody: User
+  }
+  rest CreateUser {
+    method: "POST"
+    path: "/"
+    requestBody: User
+    responses { 201: User, 202: Job }
+    errors: [UserNotFound]
+  }
+}
+`
+
+func TestServiceClientReturnsDeclaredErrors(t *testing.T) {
+	out := emitSource(t, usersContract)
+
+	expectEmitted(t, out,
+		"getUser(call: GetUserCall): Promise<GetUserResponseBody | GetUserError>;",
+		`    async getUser(call: GetUserCall): Promise<GetUserResponseBody | GetUserError> {
+        const result = await this.transport(GetUserRestInfo, {
+            path: buildGetUserPath(call.params),
+        });
+        if (result.status < 200 || result.status >= 300) {
+            const error = decodeGetUserError(result.body);
+            if (error) {
+                return error;
+            }
+            throw new RestStatusError(GetUserRestInfo, result.status, result.body);
+        }
+        return decodeGetUserResponseBody(result.body);
+    }`,
+	)
+}
+
+func TestServiceClientRejectsUnexpectedStatus(t *testing.T) {
+	out := emitSource(t, usersContract)
+
+	expectEmitted(t, out,
+		`    async ping(call: PingCall = {}): Promise<PingResponseBody> {
+        const result = await this.transport(PingRestInfo, {
+            path: PingRestInfo.path,
+        });
+        if (result.status < 200 || result.status >= 300) {
+            throw new RestStatusError(PingRestInfo, result.status, result.body);
+        }
+        return decodePingResponseBody(result.body);
+    }`,
+	)
+}
+
+func TestServiceClientKeepsStatusResponsesWithErrors(t *testing.T) {
+	out := emitSource(t, usersContract)
+
+	expectEmitted(t, out,
+		`    async createUser(call: CreateUserCall): Promise<CreateUserResponse | CreateUserError> {
+        const result = await this.transport(CreateUserRestInfo, {
+            path: CreateUserRestInfo.path,
+            body: call.body,
+        });
+        if (result.status < 200 || result.status >= 300) {
+            const error = decodeCreateUserError(result.body);
+            if (error) {
+                return error;
+            }
+        }
+        return decodeCreateUserResponse(result.status, result.body);
+    }`,
+	)
+}
@@ -1,7 +1,9 @@
 package generator
 
 import (
+	"reflect"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/smtdfc/contractor/exception"
@@ -33,10 +35,26 @@ var validatorAnnotations = map[string]struct{}{
 	"IsBool":         {},
 	"IsModel":        {},
 	"NestedValidate": {},
+	"MinItems":       {},
+	"MaxItems":       {},
+	"UniqueItems":    {},
+}
+
+var modelValidatorAnnotations = map[string]struct{}{
+	"EqualsField": {},
+	"Compare":     {},
+	"OneOf":       {},
+}
+
+var repeatableAnnotations = map[string]struct{}{
+	"Tag": {},
 }
 
 type IRGenerator struct {
 	builtinTypes map[string]struct{}
+	modelDecls   map[string]*parser.ModelDeclNode
+	constDecls   map[string]*parser.ConstDeclNode
+	validators   map[string]struct{}
 }
 
 func NewIRGenerator() *IRGenerator {
@@ -55,7 +73,7 @@ func NewIRGenerator() *IRGenerator {
 
 func (g *IRGenerator) GenerateProgram(ast *parser.ProgramNode) (*ProgramIR, exception.IException) {
 	if ast == nil {
-		return &ProgramIR{Errors: make([]*ErrorIR, 0), Models: make([]*ModelIR, 0), Enums: make([]*EnumIR, 0), Events: make([]*EventIR, 0), Rests: make([]*RestEndpointIR, 0)}, nil
+		return &ProgramIR{Annotations: make([]*AnnotationDeclIR, 0), Consts: make([]*ConstIR, 0), Errors: make([]*ErrorIR, 0), Models: make([]*ModelIR, 0), Enums: make([]*EnumIR, 0), Events: make([]*EventIR, 0), EventGroups: make([]*EventGroupIR, 0), Rests: make([]*RestEndpointIR, 0), Services: make([]*ServiceIR, 0), Rpcs: make([]*RpcIR, 0), Streams: make([]*StreamIR, 0), Channels: make([]*ChannelIR, 0)}, nil
 	}
 
 	typeSymbols, err := g.collectTypeSymbols(ast)
@@ -63,11 +81,17 @@ func (g *IRGenerator) GenerateProgram(ast *parser.ProgramNode) (*ProgramIR, exce
 		return nil, err
 	}
 
+	annotations := make([]*AnnotationDeclIR, 0)
+	consts := make([]*ConstIR, 0)
 	errors := make([]*ErrorIR, 0)
 	models := make([]*ModelIR, 0)
 	enums := make([]*EnumIR, 0)
 	events := make([]*EventIR, 0)
 	rests := make([]*RestEndpointIR, 0)
+	services := make([]*ServiceIR, 0)
+	rpcs := make([]*RpcIR, 0)
+	streams := make([]*StreamIR, 0)
+	channels := make([]*ChannelIR, 0)
 
 	for _, node := range ast.Body {
 		switch v := node.(type) {
@@ -106,20 +130,329 @@ func (g *IRGenerator) GenerateProgram(ast *parser.ProgramNode) (*ProgramIR, exce
 			}
 
 			rests = append(rests, restIR)
+		case *parser.ServiceDeclNode:
+			serviceIR, serviceRests, serviceRpcs, err := g.serviceToIR(v, typeSymbols)
+			if err != nil {
+				return nil, err
+			}
+
+			services = append(services, serviceIR)
+			rests = append(rests, serviceRests...)
+			rpcs = append(rpcs, serviceRpcs...)
+		case *parser.RpcDeclNode:
+			rpcIR, err := g.rpcToIR(v, typeSymbols)
+			if err != nil {
+				return nil, err
+			}
+
+			rpcs = append(rpcs, rpcIR)
+			rests = append(rests, rpcIR.Binding)
+		case *parser.StreamDeclNode:
+			streamIR, err := g.streamToIR(v, typeSymbols)
+			if err != nil {
+				return nil, err
+			}
+
+			streams = append(streams, streamIR)
+		case *parser.ChannelDeclNode:
+			channelIR, err := g.channelToIR(v, typeSymbols)
+			if err != nil {
+				return nil, err
+			}
+
+			channels = append(channels, channelIR)
+		case *parser.ConstDeclNode:
+			constIR, err := g.constToIR(v, typeSymbols)
+			if err != nil {
+				return nil, err
+			}
+
+			consts = append(consts, constIR)
+		case *parser.AnnotationDeclNode:
+			annotationIR, err := g.annotationDeclToIR(v, typeSymbols)
+			if err != nil {
+				return nil, err
+			}
+
+			annotations = append(annotations, annotationIR)
 		}
 	}
 
 	return &ProgramIR{
-		Errors: errors,
-		Models: models,
-		Enums:  enums,
-		Events: events,
-		Rests:  rests,
+		Annotations: annotations,
+		Consts:      consts,
+		Errors:      errors,
+		Models:      models,
+		Enums:       enums,
+		Events:      events,
+		EventGroups: eventGroups(events),
+		Rests:       rests,
+		Services:    services,
+		Rpcs:        rpcs,
+		Streams:     streams,
+		Channels:    channels,
+	}, nil
+}
+
+func (g *IRGenerator) streamToIR(node *parser.StreamDeclNode, typeSymbols map[string]TypeKind) (*StreamIR, exception.IException) {
+	if node.Name == nil {
+		return nil, exception.NewTypeException("Stream name is missing", node.GetLocation())
+	}
+
+	pathNode, ok := node.PathValue.(*parser.StringValueNode)
+	if !ok || pathNode == nil {
+		return nil, exception.NewTypeException("Stream property 'path' must be a string literal", node.GetLocation())
+	}
+
+	pathParams, queryParams, err := g.streamingParamsToIR(node.Name.Value, node.Params, node.Queries, pathNode, typeSymbols)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StreamIR{
+		Span:        toSourceSpan(node.Loc),
+		Name:        node.Name.Value,
+		Path:        pathNode.Value,
+		PathParams:  pathParams,
+		QueryParams: queryParams,
+		EventType:   g.typeToIR(node.EventType, typeSymbols, map[string]struct{}{}),
+		Annotations: g.annotationsToIR(node.Annotations),
+	}, nil
+}
+
+func (g *IRGenerator) channelToIR(node *parser.ChannelDeclNode, typeSymbols map[string]TypeKind) (*ChannelIR, exception.IException) {
+	if node.Name == nil {
+		return nil, exception.NewTypeException("Channel name is missing", node.GetLocation())
+	}
+
+	pathNode, ok := node.PathValue.(*parser.StringValueNode)
+	if !ok || pathNode == nil {
+		return nil, exception.NewTypeException("Channel property 'path' must be a string literal", node.GetLocation())
+	}
+
+	pathParams, queryParams, err := g.streamingParamsToIR(node.Name.Value, node.Params, node.Queries, pathNode, typeSymbols)
+	if err != nil {
+		return nil, err
+	}
+
+	clientMessages := make([]*TypeIR, 0, len(node.ClientMessages))
+	for _, item := range node.ClientMessages {
+		clientMessages = append(clientMessages, g.typeToIR(item, typeSymbols, map[string]struct{}{}))
+	}
+
+	serverMessages := make([]*TypeIR, 0, len(node.ServerMessages))
+	for _, item := range node.ServerMessages {
+		serverMessages = append(serverMessages, g.typeToIR(item, typeSymbols, map[string]struct{}{}))
+	}
+
+	return &ChannelIR{
+		Span:           toSourceSpan(node.Loc),
+		Name:           node.Name.Value,
+		Path:           pathNode.Value,
+		PathParams:     pathParams,
+		QueryParams:    queryParams,
+		ClientMessages: clientMessages,
+		ServerMessages: serverMessages,
+		Annotations:    g.annotationsToIR(node.Annotations),
 	}, nil
 }
 
+func (g *IRGenerator) streamingParamsToIR(name string, params *parser.FieldBlockNode, queries *parser.FieldBlockNode, pathNode *parser.StringValueNode, typeSymbols map[string]TypeKind) ([]*ModelField, []*ModelField, exception.IException) {
+	pathParams, err := g.pathParamsToIR(name, params, pathNode, typeSymbols)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	queryParams := make([]*ModelField, 0)
+	if queries != nil {
+		queryParams, err = g.fieldBlockToIR(queries, name, typeSymbols)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return pathParams, queryParams, nil
+}
+
+func (g *IRGenerator) serviceToIR(node *parser.ServiceDeclNode, typeSymbols map[string]TypeKind) (*ServiceIR, []*RestEndpointIR, []*RpcIR, exception.IException) {
+	if node.Name == nil {
+		return nil, nil, nil, exception.NewTypeException("Service name is missing", node.GetLocation())
+	}
+
+	basePath := ""
+	if value, ok := node.BasePathValue.(*parser.StringValueNode); ok && value != nil {
+		basePath = value.Value
+	}
+
+	headers := make([]*ModelField, 0)
+	if node.Headers != nil {
+		var err exception.IException
+		headers, err = g.fieldBlockToIR(node.Headers, node.Name.Value, typeSymbols)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	rests := make([]*RestEndpointIR, 0, len(node.Rests))
+	endpoints := make([]string, 0, len(node.Rests))
+	for _, rest := range node.Rests {
+		restIR, err := g.restToIR(rest, typeSymbols)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		rests = append(rests, restIR)
+		endpoints = append(endpoints, restIR.Name)
+	}
+
+	rpcs := make([]*RpcIR, 0, len(node.Rpcs))
+	rpcNames := make([]string, 0, len(node.Rpcs))
+	for _, rpc := range node.Rpcs {
+		rpcIR, err := g.rpcToIR(rpc, typeSymbols)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		rpcs = append(rpcs, rpcIR)
+		rpcNames = append(rpcNames, rpcIR.Name)
+		rests = append(rests, rpcIR.Binding)
+		endpoints = append(endpoints, rpcIR.Binding.Name)
+	}
+
+	return &ServiceIR{
+		Span:        toSourceSpan(node.Loc),
+		Name:        node.Name.Value,
+		BasePath:    basePath,
+		Headers:     headers,
+		Endpoints:   endpoints,
+		Rpcs:        rpcNames,
+		Annotations: g.annotationsToIR(node.Annotations),
+	}, rests, rpcs, nil
+}
+
+func (g *IRGenerator) rpcToIR(node *parser.RpcDeclNode, typeSymbols map[string]TypeKind) (*RpcIR, exception.IException) {
+	if node.Name == nil {
+		return nil, exception.NewTypeException("Rpc name is missing", node.GetLocation())
+	}
+
+	var requestType *TypeIR
+	if node.RequestType != nil {
+		requestType = g.typeToIR(node.RequestType, typeSymbols, map[string]struct{}{})
+	}
+	responseType := g.typeToIR(node.ResponseType, typeSymbols, map[string]struct{}{})
+
+	errorNames := make([]string, 0, len(node.Throws))
+	for _, item := range node.Throws {
+		errorNames = append(errorNames, item.Value)
+	}
+
+	serviceName := ""
+	path := parser.RpcBindingPath("", node.Name.Value)
+	annotations := g.annotationsToIR(node.Annotations)
+	bindingAnnotations := annotations
+	headers := make([]*ModelField, 0)
+	if node.Service != nil {
+		serviceName = node.Service.Name.Value
+		path = parser.RpcBindingPath(serviceName, node.Name.Value)
+		if basePath, ok := node.Service.BasePathValue.(*parser.StringValueNode); ok && basePath != nil {
+			path = parser.JoinRestPath(basePath.Value, path)
+		}
+
+		bindingAnnotations = mergeServiceAnnotations(g.annotationsToIR(node.Service.Annotations), annotations)
+
+		if node.Service.Headers != nil {
+			serviceHeaders, err := g.fieldBlockToIR(node.Service.Headers, serviceName, typeSymbols)
+			if err != nil {
+				return nil, err
+			}
+			headers = mergeServiceHeaders(serviceHeaders, headers)
+		}
+	}
+
+	return &RpcIR{
+		Span:         toSourceSpan(node.Loc),
+		Name:         node.Name.Value,
+		Service:      serviceName,
+		RequestType:  requestType,
+		ResponseType: responseType,
+		Errors:       errorNames,
+		Annotations:  annotations,
+		Binding: &RestEndpointIR{
+			Span:             toSourceSpan(node.Loc),
+			Name:             node.Name.Value,
+			Method:           "POST",
+			Path:             path,
+			RequestBodyType:  requestType,
+			ResponseBodyType: responseType,
+			Responses:        make([]*RestResponseIR, 0),
+			Queries:          make([]string, 0),
+			PathParams:       make([]*ModelField, 0),
+			QueryParams:      make([]*ModelField, 0),
+			Errors:           errorNames,
+			Headers:          headers,
+			ResponseHeaders:  make([]*ModelField, 0),
+			Annotations:      bindingAnnotations,
+		},
+	}, nil
+}
+
+func mergeServiceHeaders(serviceHeaders []*ModelField, headers []*ModelField) []*ModelField {
+	overridden := make(map[string]struct{}, len(headers))
+	for _, header := range headers {
+		overridden[strings.ToLower(header.Name)] = struct{}{}
+	}
+
+	merged := make([]*ModelField, 0, len(serviceHeaders)+len(headers))
+	for _, header := range serviceHeaders {
+		if _, ok := overridden[strings.ToLower(header.Name)]; ok {
+			continue
+		}
+
+		inherited := *header
+		inherited.IsInherited = true
+		merged = append(merged, &inherited)
+	}
+
+	return append(merged, headers...)
+}
+
+func mergeServiceAnnotations(serviceAnnotations []*AnnotationIR, annotations []*AnnotationIR) []*AnnotationIR {
+	overridden := make(map[string]struct{}, len(annotations))
+	for _, annotation := range annotations {
+		overridden[annotation.Name] = struct{}{}
+	}
+
+	merged := make([]*AnnotationIR, 0, len(serviceAnnotations)+len(annotations))
+	for _, annotation := range serviceAnnotations {
+		if _, repeatable := repeatableAnnotations[annotation.Name]; repeatable {
+			if !containsAnnotation(annotations, annotation) {
+				merged = append(merged, annotation)
+			}
+			continue
+		}
+		if _, ok := overridden[annotation.Name]; ok {
+			continue
+		}
+		merged = append(merged, annotation)
+	}
+
+	return append(merged, annotations...)
+}
+
+func containsAnnotation(annotations []*AnnotationIR, target *AnnotationIR) bool {
+	for _, annotation := range annotations {
+		if annotation.Name == target.Name && reflect.DeepEqual(annotation.Args, target.Args) {
+			return true
+		}
+	}
+	return false
+}
+
 func (g *IRGenerator) collectTypeSymbols(ast *parser.ProgramNode) (map[string]TypeKind, exception.IException) {
 	result := make(map[string]TypeKind)
+	g.modelDecls = make(map[string]*parser.ModelDeclNode)
+	g.constDecls = make(map[string]*parser.ConstDeclNode)
+	g.validators = make(map[string]struct{})
 
 	for _, node := range ast.Body {
 		switch typed := node.(type) {
@@ -133,6 +466,7 @@ func (g *IRGenerator) collectTypeSymbols(ast *parser.ProgramNode) (map[string]Ty
 			}
 
 			result[typed.Name.Value] = TypeKindModel
+			g.modelDecls[typed.Name.Value] = typed
 		case *parser.EnumDeclNode:
 			if typed.Name == nil {
 				return nil, exception.NewTypeException("Enum name is missing", typed.Loc)
@@ -143,6 +477,16 @@ func (g *IRGenerator) collectTypeSymbols(ast *parser.ProgramNode) (map[string]Ty
 			}
 
 			result[typed.Name.Value] = TypeKindEnum
+		case *parser.ConstDeclNode:
+			if typed.Name == nil {
+				return nil, exception.NewTypeException("Constant name is missing", typed.Loc)
+			}
+
+			g.constDecls[typed.Name.Value] = typed
+		case *parser.AnnotationDeclNode:
+			if typed.Name != nil && typed.IsValidator {
+				g.validators[typed.Name.Value] = struct{}{}
+			}
 		}
 	}
 
@@ -170,28 +514,36 @@ func (g *IRGenerator) modelToIR(node *parser.ModelDeclNode, typeSymbols map[stri
 		genericSymbols[item.Name.Value] = struct{}{}
 	}
 
-	fields := make([]*ModelField, 0, len(node.Fields))
-	for _, field := range node.Fields {
-		if field == nil || field.Name == nil {
+	typeParamDecls := make([]*TypeParamIR, 0, len(node.Generics))
+	for _, item := range node.Generics {
+		if item == nil || item.Name == nil {
 			continue
 		}
 
-		validators, err := g.extractValidator(field)
-		if err != nil {
-			return nil, err
+		typeParam := &TypeParamIR{Span: toSourceSpan(item.Loc), Name: item.Name.Value}
+		if item.Constraint != nil {
+			typeParam.Constraint = g.typeToIR(item.Constraint, typeSymbols, genericSymbols)
 		}
-
-		fieldType := g.typeToIR(field.Type, typeSymbols, genericSymbols)
-		fieldIR := &ModelField{
-			Span:        toSourceSpan(field.Loc),
-			Name:        field.Name.Value,
-			Annotations: g.annotationsToIR(field.Annotations),
-			Type:        fieldType,
-			IsOptional:  field.Optional,
-			Validators:  validators,
+		if item.Default != nil {
+			typeParam.Default = g.typeToIR(item.Default, typeSymbols, genericSymbols)
 		}
 
-		fields = append(fields, fieldIR)
+		typeParamDecls = append(typeParamDecls, typeParam)
+	}
+
+	fields, err := g.modelFieldsToIR(node, typeSymbols, map[string]bool{})
+	if err != nil {
+		return nil, err
+	}
+
+	var extends *TypeIR
+	if node.Extends != nil {
+		extends = g.typeToIR(node.Extends, typeSymbols, genericSymbols)
+	}
+
+	derivedFrom := ""
+	if node.Derived != nil {
+		derivedFrom = parser.FormatModelExpr(node.Derived)
 	}
 
 	sort.SliceStable(fields, func(i, j int) bool {
@@ -204,18 +556,373 @@ func (g *IRGenerator) modelToIR(node *parser.ModelDeclNode, typeSymbols map[stri
 		return false
 	})
 
+	resolveConditions(fields)
+
 	annotations := g.annotationsToIR(node.Annotations)
 	return &ModelIR{
 		Span:                toSourceSpan(node.Loc),
 		Name:                node.Name.Value,
 		TypeParams:          typeParams,
+		TypeParamDecls:      typeParamDecls,
+		Extends:             extends,
+		DerivedFrom:         derivedFrom,
 		Annotations:         annotations,
 		Fields:              fields,
+		Validators:          g.extractModelValidators(node, map[string]bool{}),
 		IsCreateConstructor: hasAnnotation(annotations, "CreateConstructor", "Constructor"),
 		IsCreateMapper:      hasAnnotation(annotations, "CreateMapper", "Mapper", "Mapping"),
 	}, nil
 }
 
+func (g *IRGenerator) modelFieldsToIR(node *parser.ModelDeclNode, typeSymbols map[string]TypeKind, visited map[string]bool) ([]*ModelField, exception.IException) {
+	if node == nil || node.Name == nil || visited[node.Name.Value] {
+		return make([]*ModelField, 0), nil
+	}
+	visited[node.Name.Value] = true
+	defer delete(visited, node.Name.Value)
+
+	genericSymbols := make(map[string]struct{})
+	for _, item := range node.Generics {
+		if item != nil && item.Name != nil {
+			genericSymbols[item.Name.Value] = struct{}{}
+		}
+	}
+
+	if node.Derived != nil {
+		return g.modelExprToIR(node.Derived, typeSymbols, genericSymbols, visited)
+	}
+
+	fields := make([]*ModelField, 0, len(node.Fields))
+	parentRefs := make([]*parser.TypeDeclNode, 0, len(node.Spreads)+1)
+	if node.Extends != nil {
+		parentRefs = append(parentRefs, node.Extends)
+	}
+	for _, spread := range node.Spreads {
+		if spread != nil {
+			parentRefs = append(parentRefs, spread.Type)
+		}
+	}
+
+	for i, ref := range parentRefs {
+		if ref == nil || ref.Name == nil {
+			continue
+		}
+
+		parent, ok := g.modelDecls[ref.Name.Value]
+		if !ok {
+			return nil, exception.NewTypeException("Model '"+ref.Name.Value+"' is not defined", ref.Loc)
+		}
+
+		parentFields, err := g.modelFieldsToIR(parent, typeSymbols, visited)
+		if err != nil {
+			return nil, err
+		}
+
+		bindings := typeBindings(parent, g.typeToIR(ref, typeSymbols, genericSymbols))
+		isInherited := i == 0 && node.Extends != nil
+		for _, field := range parentFields {
+			copied := *field
+			copied.Type = substituteType(field.Type, bindings)
+			copied.IsInherited = isInherited
+			fields = append(fields, &copied)
+		}
+	}
+
+	for _, field := range node.Fields {
+		if field == nil || field.Name == nil {
+			continue
+		}
+
+		fieldIR, err := g.fieldToIR(field, node.Name.Value, typeSymbols, genericSymbols)
+		if err != nil {
+			return nil, err
+		}
+
+		fields = append(fields, fieldIR)
+	}
+
+	return fields, nil
+}
+
+func (g *IRGenerator) fieldToIR(field *parser.ModelFieldDeclNode, declaredIn string, typeSymbols map[string]TypeKind, genericSymbols map[string]struct{}) (*ModelField, exception.IException) {
+	validators, err := g.extractValidator(field)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldType := g.typeToIR(field.Type, typeSymbols, genericSymbols)
+	resolveEnumValidatorArgs(validators, fieldType)
+	return &ModelField{
+		Span:         toSourceSpan(field.Loc),
+		Name:         field.Name.Value,
+		DeclaredIn:   declaredIn,
+		Annotations:  g.annotationsToIR(field.Annotations),
+		Type:         fieldType,
+		IsOptional:   field.Optional,
+		DefaultValue: g.defaultValueToIR(field.DefaultValue, fieldType),
+		Validators:   validators,
+	}, nil
+}
+
+func (g *IRGenerator) fieldBlockToIR(block *parser.FieldBlockNode, declaredIn string, typeSymbols map[string]TypeKind) ([]*ModelField, exception.IException) {
+	fields := make([]*ModelField, 0)
+	if block == nil {
+		return fields, nil
+	}
+
+	for _, field := range block.Fields {
+		if field == nil || field.Name == nil {
+			continue
+		}
+
+		fieldIR, err := g.fieldToIR(field, declaredIn, typeSymbols, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		fields = append(fields, fieldIR)
+	}
+
+	resolveConditions(fields)
+	return fields, nil
+}
+
+func (g *IRGenerator) modelExprToIR(node *parser.ModelExprNode, typeSymbols map[string]TypeKind, genericSymbols map[string]struct{}, visited map[string]bool) ([]*ModelField, exception.IException) {
+	if node.Utility == nil {
+		source, ok := g.modelDecls[node.Ref.Name.Value]
+		if !ok {
+			return nil, exception.NewTypeException("Model '"+node.Ref.Name.Value+"' is not defined", node.Ref.Loc)
+		}
+
+		sourceFields, err := g.modelFieldsToIR(source, typeSymbols, visited)
+		if err != nil {
+			return nil, err
+		}
+
+		bindings := typeBindings(source, g.typeToIR(node.Ref, typeSymbols, genericSymbols))
+		fields := make([]*ModelField, 0, len(sourceFields))
+		for _, field := range sourceFields {
+			copied := *field
+			copied.Type = substituteType(field.Type, bindings)
+			copied.IsInherited = false
+			fields = append(fields, &copied)
+		}
+
+		return fields, nil
+	}
+
+	fields, err := g.modelExprToIR(node.Operand, typeSymbols, genericSymbols, visited)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]bool, len(node.Keys))
+	for _, key := range node.Keys {
+		keys[key.Value] = true
+	}
+
+	result := make([]*ModelField, 0, len(fields))
+	for _, field := range fields {
+		switch node.Utility.Value {
+		case "Partial":
+			field.IsOptional = true
+			field.DefaultValue = nil
+		case "Required":
+			field.IsOptional = false
+		case "Pick":
+			if !keys[field.Name] {
+				continue
+			}
+		case "Omit":
+			if keys[field.Name] {
+				continue
+			}
+		}
+
+		result = append(result, field)
+	}
+
+	return result, nil
+}
+
+func resolveEnumValidatorArgs(validators []*FieldValidator, fieldType *TypeIR) {
+	for _, validator := range validators {
+		target := fieldType
+		if validator.IsEach && target != nil && target.Name == "Array" && len(target.Generics) == 1 {
+			target = target.Generics[0]
+		}
+
+		if target == nil || target.Kind != TypeKindEnum {
+			continue
+		}
+
+		for i, arg := range validator.Args {
+			validator.Args[i] = enumMemberValue(arg)
+		}
+	}
+}
+
+func enumMemberValue(value *ValueIR) *ValueIR {
+	if value == nil || value.Ref != "" {
+		return value
+	}
+
+	switch value.Kind {
+	case "Reference":
+		return &ValueIR{Kind: ValueKindEnumMember, Value: value.Value}
+	case "Array":
+		items, ok := value.Value.([]*ValueIR)
+		if !ok {
+			return value
+		}
+
+		for i, item := range items {
+			items[i] = enumMemberValue(item)
+		}
+	}
+
+	return value
+}
+
+func resolveConditions(fields []*ModelField) {
+	types := make(map[string]*TypeIR, len(fields))
+	for _, field := range fields {
+		types[field.Name] = field.Type
+	}
+
+	for _, field := range fields {
+		for _, validator := range field.Validators {
+			condition := validator.Condition
+			if condition == nil {
+				continue
+			}
+
+			condition.Type = types[condition.Field]
+			if condition.Type != nil && condition.Type.Kind == TypeKindEnum && condition.Value.Kind == "Reference" && condition.Value.Ref == "" {
+				condition.Value = &ValueIR{Kind: ValueKindEnumMember, Value: condition.Value.Value}
+			}
+		}
+	}
+}
+
+func typeBindings(node *parser.ModelDeclNode, ref *TypeIR) map[string]*TypeIR {
+	bindings := make(map[string]*TypeIR)
+	if node == nil || ref == nil {
+		return bindings
+	}
+
+	for i, item := range node.Generics {
+		if item == nil || item.Name == nil || i >= len(ref.Generics) {
+			continue
+		}
+
+		bindings[item.Name.Value] = ref.Generics[i]
+	}
+
+	return bindings
+}
+
+func substituteType(t *TypeIR, bindings map[string]*TypeIR) *TypeIR {
+	if t == nil || len(bindings) == 0 {
+		return t
+	}
+
+	if t.Kind == TypeKindGeneric {
+		if bound, ok := bindings[t.Name]; ok {
+			return bound
+		}
+	}
+
+	copied := *t
+	copied.Generics = make([]*TypeIR, 0, len(t.Generics))
+	for _, item := range t.Generics {
+		copied.Generics = append(copied.Generics, substituteType(item, bindings))
+	}
+
+	if t.TypeArgs != nil {
+		copied.TypeArgs = make([]*TypeArgIR, 0, len(t.TypeArgs))
+		for _, arg := range t.TypeArgs {
+			copied.TypeArgs = append(copied.TypeArgs, &TypeArgIR{
+				Param:     arg.Param,
+				Type:      substituteType(arg.Type, bindings),
+				IsDefault: arg.IsDefault,
+			})
+		}
+	}
+
+	return &copied
+}
+
+func (g *IRGenerator) constToIR(node *parser.ConstDeclNode, typeSymbols map[string]TypeKind) (*ConstIR, exception.IException) {
+	if node.Name == nil {
+		return nil, exception.NewTypeException("Constant name is missing", node.Loc)
+	}
+
+	constType := g.typeToIR(node.Type, typeSymbols, nil)
+	return &ConstIR{
+		Span:        toSourceSpan(node.Loc),
+		Name:        node.Name.Value,
+		Type:        constType,
+		Value:       g.defaultValueToIR(node.Value, constType),
+		Annotations: g.annotationsToIR(node.Annotations),
+	}, nil
+}
+
+func (g *IRGenerator) annotationDeclToIR(node *parser.AnnotationDeclNode, typeSymbols map[string]TypeKind) (*AnnotationDeclIR, exception.IException) {
+	if node.Name == nil {
+		return nil, exception.NewTypeException("Annotation name is missing", node.Loc)
+	}
+
+	params := make([]*AnnotationParamIR, 0, len(node.Params))
+	for _, param := range node.Params {
+		params = append(params, &AnnotationParamIR{
+			Name: param.Name.Value,
+			Type: g.typeToIR(param.Type, typeSymbols, nil),
+		})
+	}
+
+	targets := make([]string, 0, len(node.Targets))
+	for _, target := range node.Targets {
+		targets = append(targets, target.Value)
+	}
+
+	forTypes := make([]*TypeIR, 0, len(node.ForTypes))
+	for _, forType := range node.ForTypes {
+		forTypes = append(forTypes, g.typeToIR(forType, typeSymbols, nil))
+	}
+
+	return &AnnotationDeclIR{
+		Span:        toSourceSpan(node.Loc),
+		Name:        node.Name.Value,
+		Params:      params,
+		Targets:     targets,
+		IsValidator: node.IsValidator,
+		ForTypes:    forTypes,
+	}, nil
+}
+
+func (g *IRGenerator) resolveConst(node parser.ASTValueNode) (parser.ASTValueNode, string) {
+	ref := ""
+	visited := make(map[string]bool)
+	for {
+		value, ok := node.(*parser.ReferenceValueNode)
+		if !ok || value.Name == nil || visited[value.Name.Value] {
+			return node, ref
+		}
+
+		decl, ok := g.constDecls[value.Name.Value]
+		if !ok {
+			return node, ref
+		}
+
+		if ref == "" {
+			ref = value.Name.Value
+		}
+		visited[value.Name.Value] = true
+		node = decl.Value
+	}
+}
+
 func (g *IRGenerator) enumToIR(node *parser.EnumDeclNode) (*EnumIR, exception.IException) {
 	if node == nil {
 		fallbackLoc := parser.NewLocation("<unknown>", parser.NewPosition(1, 1), parser.NewPosition(1, 1))
@@ -225,19 +932,33 @@ func (g *IRGenerator) enumToIR(node *parser.EnumDeclNode) (*EnumIR, exception.IE
 		return nil, exception.NewTypeException("Enum name is missing", node.GetLocation())
 	}
 
-	members := make([]string, 0, len(node.Members))
+	valueKind := parser.EnumValueKind(node)
+	members := make([]*EnumMemberIR, 0, len(node.Members))
 	for _, member := range node.Members {
-		if member == nil {
+		if member == nil || member.Name == nil {
 			continue
 		}
 
-		members = append(members, member.Value)
+		value := &ValueIR{Kind: "String", Value: member.Name.Value}
+		if member.Value != nil {
+			value = g.valueToIR(member.Value)
+		}
+
+		members = append(members, &EnumMemberIR{
+			Span:        toSourceSpan(member.Loc),
+			Name:        member.Name.Value,
+			Value:       value,
+			Annotations: g.annotationsToIR(member.Annotations),
+			Doc:         member.Doc,
+		})
 	}
 
 	return &EnumIR{
-		Span:    toSourceSpan(node.Loc),
-		Name:    node.Name.Value,
-		Members: members,
+		Span:        toSourceSpan(node.Loc),
+		Name:        node.Name.Value,
+		ValueKind:   valueKind,
+		Members:     members,
+		Annotations: g.annotationsToIR(node.Annotations),
 	}, nil
 }
 
@@ -257,20 +978,119 @@ func (g *IRGenerator) eventToIR(node *parser.EventDeclNode, typeSymbols map[stri
 		eventName = nameValue.Value
 	}
 
+	topic := ""
+	if topicValue, ok := node.TopicValue.(*parser.StringValueNode); ok && topicValue != nil {
+		topic = topicValue.Value
+	}
+
+	dataSchema := ""
+	if dataSchemaValue, ok := node.DataSchemaValue.(*parser.StringValueNode); ok && dataSchemaValue != nil {
+		dataSchema = dataSchemaValue.Value
+	}
+
+	keyName := ""
+	switch v := node.KeyValue.(type) {
+	case *parser.ReferenceValueNode:
+		keyName = v.Name.Value
+	case *parser.StringValueNode:
+		keyName = v.Value
+	}
+
+	var key *ModelField
+	if payload, ok := g.modelDecls[node.PayloadType.Name.Value]; ok && keyName != "" {
+		fields, err := g.modelFieldsToIR(payload, typeSymbols, map[string]bool{})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, field := range fields {
+			if field.Name == keyName {
+				key = field
+				break
+			}
+		}
+	}
+
+	headers := make([]*ModelField, 0)
+	if node.Headers != nil {
+		var err exception.IException
+		headers, err = g.fieldBlockToIR(node.Headers, node.Name.Value, typeSymbols)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &EventIR{
 		Span:        toSourceSpan(node.Loc),
 		Name:        node.Name.Value,
 		EventName:   eventName,
+		Topic:       topic,
+		Key:         key,
+		Version:     parser.EventVersion(node),
+		DataSchema:  dataSchema,
+		Headers:     headers,
 		PayloadType: payloadType,
+		Annotations: g.annotationsToIR(node.Annotations),
 	}, nil
 }
 
+func eventGroups(events []*EventIR) []*EventGroupIR {
+	groups := make([]*EventGroupIR, 0)
+	byName := make(map[string]*EventGroupIR)
+	for _, event := range events {
+		group, ok := byName[event.Name]
+		if !ok {
+			group = &EventGroupIR{Name: event.Name, EventName: event.EventName}
+			byName[event.Name] = group
+			groups = append(groups, group)
+		}
+
+		group.Versions = append(group.Versions, event)
+	}
+
+	result := make([]*EventGroupIR, 0, len(groups))
+	for _, group := range groups {
+		if len(group.Versions) < 2 {
+			continue
+		}
+
+		sort.SliceStable(group.Versions, func(i, j int) bool {
+			return group.Versions[i].Version < group.Versions[j].Version
+		})
+		result = append(result, group)
+	}
+
+	return result
+}
+
 func (g *IRGenerator) extractValidator(node *parser.ModelFieldDeclNode) ([]*FieldValidator, exception.IException) {
 
 	validators := []*FieldValidator{}
 
+	var condition *ValidatorCondition
 	for _, anno := range node.Annotations {
-		if _, ok := validatorAnnotations[anno.Name.Value]; ok {
+		if anno.Name.Value == "When" && len(anno.Args) == 2 {
+			field, _ := g.valueToIR(anno.Args[0]).Value.(string)
+			condition = &ValidatorCondition{
+				Field: field,
+				Value: g.valueToIR(anno.Args[1]),
+			}
+			continue
+		}
+
+		isEach := false
+		if anno.Name.Value == "Each" && len(anno.Args) == 1 {
+			inner, ok := anno.Args[0].(*parser.AnnotationValueNode)
+			if !ok || inner.Annotation == nil || inner.Annotation.Name == nil {
+				continue
+			}
+
+			anno = inner.Annotation
+			isEach = true
+		}
+
+		_, isCustom := g.validators[anno.Name.Value]
+		if _, ok := validatorAnnotations[anno.Name.Value]; ok || isCustom {
 			args := []*ValueIR{}
 			for _, arg := range anno.Args {
 				value := g.valueToIR(arg)
@@ -278,8 +1098,11 @@ func (g *IRGenerator) extractValidator(node *parser.ModelFieldDeclNode) ([]*Fiel
 			}
 
 			validators = append(validators, &FieldValidator{
-				Name: anno.Name.Value,
-				Args: args,
+				Name:      anno.Name.Value,
+				Args:      args,
+				IsCustom:  isCustom,
+				IsEach:    isEach,
+				Condition: condition,
 			})
 		}
 	}
@@ -287,6 +1110,52 @@ func (g *IRGenerator) extractValidator(node *parser.ModelFieldDeclNode) ([]*Fiel
 	return validators, nil
 }
 
+func (g *IRGenerator) extractModelValidators(node *parser.ModelDeclNode, visited map[string]bool) []*ModelValidator {
+	validators := []*ModelValidator{}
+	if node == nil || node.Name == nil || visited[node.Name.Value] {
+		return validators
+	}
+	visited[node.Name.Value] = true
+
+	parentRefs := make([]*parser.TypeDeclNode, 0, len(node.Spreads)+1)
+	if node.Extends != nil {
+		parentRefs = append(parentRefs, node.Extends)
+	}
+	for _, spread := range node.Spreads {
+		if spread != nil {
+			parentRefs = append(parentRefs, spread.Type)
+		}
+	}
+
+	for _, ref := range parentRefs {
+		if ref == nil || ref.Name == nil {
+			continue
+		}
+
+		if parent, ok := g.modelDecls[ref.Name.Value]; ok {
+			validators = append(validators, g.extractModelValidators(parent, visited)...)
+		}
+	}
+
+	for _, anno := range node.Annotations {
+		if _, ok := modelValidatorAnnotations[anno.Name.Value]; !ok {
+			continue
+		}
+
+		args := []*ValueIR{}
+		for _, arg := range anno.Args {
+			args = append(args, g.valueToIR(arg))
+		}
+
+		validators = append(validators, &ModelValidator{
+			Name: anno.Name.Value,
+			Args: args,
+		})
+	}
+
+	return validators
+}
+
 func (g *IRGenerator) restToIR(node *parser.RestDeclNode, typeSymbols map[string]TypeKind) (*RestEndpointIR, exception.IException) {
 	if node == nil {
 		fallbackLoc := parser.NewLocation("<unknown>", parser.NewPosition(1, 1), parser.NewPosition(1, 1))
@@ -307,11 +1176,55 @@ func (g *IRGenerator) restToIR(node *parser.RestDeclNode, typeSymbols map[string
 		return nil, exception.NewTypeException("Rest property 'path' must be a string literal", node.GetLocation())
 	}
 
+	if node.Service != nil {
+		if basePath, ok := node.Service.BasePathValue.(*parser.StringValueNode); ok && basePath != nil {
+			pathNode = &parser.StringValueNode{Value: parser.JoinRestPath(basePath.Value, pathNode.Value), Loc: pathNode.Loc}
+		}
+	}
+
 	queries, err := g.queriesToIR(node.QueriesValue)
 	if err != nil {
 		return nil, err
 	}
 
+	errorNames, err := g.restErrorsToIR(node.ErrorsValue)
+	if err != nil {
+		return nil, err
+	}
+
+	pathParams, err := g.pathParamsToIR(node.Name.Value, node.Params, pathNode, typeSymbols)
+	if err != nil {
+		return nil, err
+	}
+
+	queryParams := make([]*ModelField, 0)
+	if node.Queries != nil {
+		queryParams, err = g.fieldBlockToIR(node.Queries, node.Name.Value, typeSymbols)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, param := range queryParams {
+			queries = append(queries, param.Name)
+		}
+	}
+
+	headers := make([]*ModelField, 0)
+	if node.Headers != nil {
+		headers, err = g.fieldBlockToIR(node.Headers, node.Name.Value, typeSymbols)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	responseHeaders := make([]*ModelField, 0)
+	if node.ResponseHeaders != nil {
+		responseHeaders, err = g.fieldBlockToIR(node.ResponseHeaders, node.Name.Value, typeSymbols)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	requestType := g.typeToIR(node.RequestBodyType, typeSymbols, map[string]struct{}{})
 	if node.RequestBodyType == nil {
 		requestType = nil
@@ -322,6 +1235,33 @@ func (g *IRGenerator) restToIR(node *parser.RestDeclNode, typeSymbols map[string
 		responseType = nil
 	}
 
+	responses := make([]*RestResponseIR, 0, len(node.Responses))
+	for _, response := range node.Responses {
+		status, convErr := strconv.Atoi(response.Status.Value)
+		if convErr != nil {
+			return nil, exception.NewTypeException("Response status must be an integer", response.Status.Loc)
+		}
+
+		responses = append(responses, &RestResponseIR{
+			Span:   toSourceSpan(response.Loc),
+			Status: status,
+			Type:   g.typeToIR(response.Type, typeSymbols, map[string]struct{}{}),
+		})
+	}
+
+	annotations := g.annotationsToIR(node.Annotations)
+	if node.Service != nil {
+		annotations = mergeServiceAnnotations(g.annotationsToIR(node.Service.Annotations), annotations)
+
+		if node.Service.Headers != nil {
+			serviceHeaders, err := g.fieldBlockToIR(node.Service.Headers, node.Service.Name.Value, typeSymbols)
+			if err != nil {
+				return nil, err
+			}
+			headers = mergeServiceHeaders(serviceHeaders, headers)
+		}
+	}
+
 	return &RestEndpointIR{
 		Span:             toSourceSpan(node.Loc),
 		Name:             node.Name.Value,
@@ -329,10 +1269,42 @@ func (g *IRGenerator) restToIR(node *parser.RestDeclNode, typeSymbols map[string
 		Path:             pathNode.Value,
 		RequestBodyType:  requestType,
 		ResponseBodyType: responseType,
+		Responses:        responses,
 		Queries:          queries,
+		PathParams:       pathParams,
+		QueryParams:      queryParams,
+		Errors:           errorNames,
+		Headers:          headers,
+		ResponseHeaders:  responseHeaders,
+		Annotations:      annotations,
 	}, nil
 }
 
+func (g *IRGenerator) pathParamsToIR(declaredIn string, block *parser.FieldBlockNode, pathNode *parser.StringValueNode, typeSymbols map[string]TypeKind) ([]*ModelField, exception.IException) {
+	if block != nil {
+		return g.fieldBlockToIR(block, declaredIn, typeSymbols)
+	}
+
+	names, err := parser.ParsePathParams(pathNode.Value)
+	if err != nil {
+		return nil, exception.NewTypeException("Invalid path template: "+err.Error(), pathNode.Loc)
+	}
+
+	params := make([]*ModelField, 0, len(names))
+	for _, name := range names {
+		params = append(params, &ModelField{
+			Span:        toSourceSpan(pathNode.Loc),
+			Name:        name,
+			DeclaredIn:  declaredIn,
+			Annotations: make([]*AnnotationIR, 0),
+			Type:        &TypeIR{Kind: TypeKindBuiltin, Name: "String", Generics: make([]*TypeIR, 0)},
+			Validators:  make([]*FieldValidator, 0),
+		})
+	}
+
+	return params, nil
+}
+
 func (g *IRGenerator) errorToIR(node *parser.ErrorDeclNode) (*ErrorIR, exception.IException) {
 	if node == nil {
 		fallbackLoc := parser.NewLocation("<unknown>", parser.NewPosition(1, 1), parser.NewPosition(1, 1))
@@ -349,12 +1321,13 @@ func (g *IRGenerator) errorToIR(node *parser.ErrorDeclNode) (*ErrorIR, exception
 	}
 
 	return &ErrorIR{
-		Span:    toSourceSpan(node.Loc),
-		Name:    node.Name.Value,
-		Code:    stringValueOrNil(node.CodeValue),
-		Message: message,
-		Scope:   stringValueOrNil(node.ScopeValue),
-		Status:  statusValueOrNil(node.StatusValue),
+		Span:        toSourceSpan(node.Loc),
+		Name:        node.Name.Value,
+		Code:        stringValueOrNil(node.CodeValue),
+		Message:     message,
+		Scope:       stringValueOrNil(node.ScopeValue),
+		Status:      statusValueOrNil(node.StatusValue),
+		Annotations: g.annotationsToIR(node.Annotations),
 	}, nil
 }
 
@@ -381,6 +1354,29 @@ func (g *IRGenerator) queriesToIR(node parser.ASTValueNode) ([]string, exception
 	return queries, nil
 }
 
+func (g *IRGenerator) restErrorsToIR(node parser.ASTValueNode) ([]string, exception.IException) {
+	if node == nil {
+		return make([]string, 0), nil
+	}
+
+	arrayNode, ok := node.(*parser.ArrayValueNode)
+	if !ok {
+		return nil, exception.NewTypeException("Rest property 'errors' must be an array literal", node.GetLocation())
+	}
+
+	errorNames := make([]string, 0, len(arrayNode.Values))
+	for _, item := range arrayNode.Values {
+		ref, ok := item.(*parser.ReferenceValueNode)
+		if !ok || ref.Name == nil {
+			return nil, exception.NewTypeException("Rest property 'errors' must be a list of error names", item.GetLocation())
+		}
+
+		errorNames = append(errorNames, ref.Name.Value)
+	}
+
+	return errorNames, nil
+}
+
 func (g *IRGenerator) typeToIR(node *parser.TypeDeclNode, typeSymbols map[string]TypeKind, genericSymbols map[string]struct{}) *TypeIR {
 	if node == nil || node.Name == nil {
 		return &TypeIR{Kind: TypeKindUnknown}
@@ -402,20 +1398,70 @@ func (g *IRGenerator) typeToIR(node *parser.TypeDeclNode, typeSymbols map[string
 		generics = append(generics, g.typeToIR(item, typeSymbols, genericSymbols))
 	}
 
+	if decl, ok := g.modelDecls[name]; ok && kind == TypeKindModel && len(generics) < len(decl.Generics) {
+		generics = g.fillDefaultTypeArgs(decl, generics, typeSymbols)
+	}
+
 	resolvedRef := ""
 	if kind == TypeKindModel || kind == TypeKindEnum {
 		resolvedRef = name
 	}
 
+	var typeArgs []*TypeArgIR
+	if decl, ok := g.modelDecls[name]; ok && kind == TypeKindModel && len(decl.Generics) > 0 {
+		typeArgs = make([]*TypeArgIR, 0, len(decl.Generics))
+		for i, item := range decl.Generics {
+			if item == nil || item.Name == nil || i >= len(generics) {
+				continue
+			}
+
+			typeArgs = append(typeArgs, &TypeArgIR{
+				Param:     item.Name.Value,
+				Type:      generics[i],
+				IsDefault: i >= len(node.Generics),
+			})
+		}
+	}
+
 	return &TypeIR{
 		Span:        toSourceSpan(node.Loc),
 		Kind:        kind,
 		Name:        name,
 		Generics:    generics,
+		TypeArgs:    typeArgs,
 		ResolvedRef: resolvedRef,
 	}
 }
 
+func (g *IRGenerator) fillDefaultTypeArgs(decl *parser.ModelDeclNode, args []*TypeIR, typeSymbols map[string]TypeKind) []*TypeIR {
+	declGenerics := make(map[string]struct{}, len(decl.Generics))
+	for _, item := range decl.Generics {
+		if item != nil && item.Name != nil {
+			declGenerics[item.Name.Value] = struct{}{}
+		}
+	}
+
+	bindings := make(map[string]*TypeIR, len(decl.Generics))
+	result := make([]*TypeIR, 0, len(decl.Generics))
+	for i, item := range decl.Generics {
+		if item == nil || item.Name == nil {
+			continue
+		}
+
+		arg := &TypeIR{Kind: TypeKindBuiltin, Name: "Any"}
+		if i < len(args) {
+			arg = args[i]
+		} else if item.Default != nil {
+			arg = substituteType(g.typeToIR(item.Default, typeSymbols, declGenerics), bindings)
+		}
+
+		bindings[item.Name.Value] = arg
+		result = append(result, arg)
+	}
+
+	return result
+}
+
 func (g *IRGenerator) annotationsToIR(nodes []*parser.AnnotationNode) []*AnnotationIR {
 	items := make([]*AnnotationIR, 0, len(nodes))
 	for _, node := range nodes {
@@ -451,6 +1497,13 @@ func (g *IRGenerator) valueToIR(node parser.ASTValueNode) *ValueIR {
 		return &ValueIR{Kind: v.GetKind(), Value: v.Value}
 	case *parser.NullValueNode:
 		return &ValueIR{Kind: v.GetKind(), Value: "null"}
+	case *parser.ReferenceValueNode:
+		if resolved, ref := g.resolveConst(v); ref != "" {
+			value := g.valueToIR(resolved)
+			value.Ref = ref
+			return value
+		}
+		return &ValueIR{Kind: v.GetKind(), Value: v.Name.Value}
 	case *parser.ArrayValueNode:
 		values := make([]*ValueIR, 0, len(v.Values))
 		for _, item := range v.Values {
@@ -462,6 +1515,19 @@ func (g *IRGenerator) valueToIR(node parser.ASTValueNode) *ValueIR {
 	}
 }
 
+func (g *IRGenerator) defaultValueToIR(node parser.ASTValueNode, fieldType *TypeIR) *ValueIR {
+	if node == nil {
+		return nil
+	}
+
+	resolved, constRef := g.resolveConst(node)
+	if ref, ok := resolved.(*parser.ReferenceValueNode); ok && fieldType != nil && fieldType.Kind == TypeKindEnum {
+		return &ValueIR{Kind: ValueKindEnumMember, Value: ref.Name.Value, Ref: constRef}
+	}
+
+	return g.valueToIR(node)
+}
+
 func stringValueOrNil(node parser.ASTValueNode) *string {
 	if node == nil {
 		return nil
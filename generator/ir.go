@@ -29,13 +29,34 @@ func (s *SourceSpan) ToLocation() *parser.Location {
 type ValueIR struct {
 	Kind  string
 	Value any
+	Ref   string
 }
 
+const ValueKindEnumMember = "EnumMember"
+
 type AnnotationIR struct {
 	Name string
 	Args []*ValueIR
 }
 
+type AnnotationDeclIR struct {
+	Span        *SourceSpan
+	Name        string
+	Params      []*AnnotationParamIR
+	Targets     []string
+	IsValidator bool
+	ForTypes    []*TypeIR
+}
+
+func (a *AnnotationDeclIR) GetKind() string {
+	return "annotation"
+}
+
+type AnnotationParamIR struct {
+	Name string
+	Type *TypeIR
+}
+
 type TypeKind string
 
 const (
@@ -50,8 +71,12 @@ type ModelIR struct {
 	Span                *SourceSpan
 	Name                string
 	TypeParams          []string
+	TypeParamDecls      []*TypeParamIR
+	Extends             *TypeIR
+	DerivedFrom         string
 	Annotations         []*AnnotationIR
 	Fields              []*ModelField
+	Validators          []*ModelValidator
 	IsCreateConstructor bool
 	IsCreateMapper      bool
 }
@@ -61,24 +86,44 @@ func (m *ModelIR) GetKind() string {
 }
 
 type ProgramIR struct {
-	Errors []*ErrorIR
-	Models []*ModelIR
-	Enums  []*EnumIR
-	Events []*EventIR
-	Rests  []*RestEndpointIR
+	Annotations []*AnnotationDeclIR
+	Consts      []*ConstIR
+	Errors      []*ErrorIR
+	Models      []*ModelIR
+	Enums       []*EnumIR
+	Events      []*EventIR
+	EventGroups []*EventGroupIR
+	Rests       []*RestEndpointIR
+	Services    []*ServiceIR
+	Rpcs        []*RpcIR
+	Streams     []*StreamIR
+	Channels    []*ChannelIR
 }
 
 func (p *ProgramIR) GetKind() string {
 	return "program"
 }
 
+type ConstIR struct {
+	Span        *SourceSpan
+	Name        string
+	Type        *TypeIR
+	Value       *ValueIR
+	Annotations []*AnnotationIR
+}
+
+func (c *ConstIR) GetKind() string {
+	return "const"
+}
+
 type ErrorIR struct {
-	Span    *SourceSpan
-	Name    string
-	Code    *string
-	Message string
-	Scope   *string
-	Status  *string
+	Span        *SourceSpan
+	Name        string
+	Code        *string
+	Message     string
+	Scope       *string
+	Status      *string
+	Annotations []*AnnotationIR
 }
 
 func (e *ErrorIR) GetKind() string {
@@ -86,29 +131,57 @@ func (e *ErrorIR) GetKind() string {
 }
 
 type EnumIR struct {
-	Span    *SourceSpan
-	Name    string
-	Members []string
+	Span        *SourceSpan
+	Name        string
+	ValueKind   string
+	Members     []*EnumMemberIR
+	Annotations []*AnnotationIR
 }
 
 func (e *EnumIR) GetKind() string {
 	return "enum"
 }
 
+type EnumMemberIR struct {
+	Span        *SourceSpan
+	Name        string
+	Value       *ValueIR
+	Annotations []*AnnotationIR
+	Doc         string
+}
+
 type EventIR struct {
 	Span        *SourceSpan
 	Name        string
 	EventName   string
+	Topic       string
+	Key         *ModelField
+	Version     int
+	DataSchema  string
+	Headers     []*ModelField
 	PayloadType *TypeIR
+	Annotations []*AnnotationIR
 }
 
 func (e *EventIR) GetKind() string {
 	return "event"
 }
 
+type EventGroupIR struct {
+	Name      string
+	EventName string
+	Versions  []*EventIR
+}
+
+func (e *EventGroupIR) GetKind() string {
+	return "event_group"
+}
+
 type ModelField struct {
 	Span         *SourceSpan
 	Name         string
+	DeclaredIn   string
+	IsInherited  bool
 	Annotations  []*AnnotationIR
 	Type         *TypeIR
 	IsOptional   bool
@@ -117,15 +190,37 @@ type ModelField struct {
 }
 
 type FieldValidator struct {
+	Name      string
+	Args      []*ValueIR
+	IsCustom  bool
+	IsEach    bool
+	Condition *ValidatorCondition
+}
+
+type ValidatorCondition struct {
+	Field string
+	Value *ValueIR
+	Type  *TypeIR
+}
+
+type ModelValidator struct {
 	Name string
 	Args []*ValueIR
 }
 
+type TypeParamIR struct {
+	Span       *SourceSpan
+	Name       string
+	Constraint *TypeIR
+	Default    *TypeIR
+}
+
 type TypeIR struct {
 	Span        *SourceSpan
 	Kind        TypeKind
 	Name        string
 	Generics    []*TypeIR
+	TypeArgs    []*TypeArgIR
 	ResolvedRef string
 }
 
@@ -133,6 +228,76 @@ func (t *TypeIR) GetKind() string {
 	return "type"
 }
 
+type TypeArgIR struct {
+	Param     string
+	Type      *TypeIR
+	IsDefault bool
+}
+
+type ServiceIR struct {
+	Span        *SourceSpan
+	Name        string
+	BasePath    string
+	Headers     []*ModelField
+	Endpoints   []string
+	Rpcs        []string
+	Annotations []*AnnotationIR
+}
+
+type StreamIR struct {
+	Span        *SourceSpan
+	Name        string
+	Path        string
+	PathParams  []*ModelField
+	QueryParams []*ModelField
+	EventType   *TypeIR
+	Annotations []*AnnotationIR
+}
+
+func (s *StreamIR) GetKind() string {
+	return "stream"
+}
+
+type ChannelIR struct {
+	Span           *SourceSpan
+	Name           string
+	Path           string
+	PathParams     []*ModelField
+	QueryParams    []*ModelField
+	ClientMessages []*TypeIR
+	ServerMessages []*TypeIR
+	Annotations    []*AnnotationIR
+}
+
+func (c *ChannelIR) GetKind() string {
+	return "channel"
+}
+
+type RpcIR struct {
+	Span         *SourceSpan
+	Name         string
+	Service      string
+	RequestType  *TypeIR
+	ResponseType *TypeIR
+	Errors       []string
+	Binding      *RestEndpointIR
+	Annotations  []*AnnotationIR
+}
+
+func (r *RpcIR) GetKind() string {
+	return "rpc"
+}
+
+func (s *ServiceIR) GetKind() string {
+	return "service"
+}
+
+type RestResponseIR struct {
+	Span   *SourceSpan
+	Status int
+	Type   *TypeIR
+}
+
 type RestEndpointIR struct {
 	Span             *SourceSpan
 	Name             string
@@ -140,7 +305,14 @@ type RestEndpointIR struct {
 	Path             string
 	RequestBodyType  *TypeIR
 	ResponseBodyType *TypeIR
+	Responses        []*RestResponseIR
 	Queries          []string
+	PathParams       []*ModelField
+	QueryParams      []*ModelField
+	Errors           []string
+	Headers          []*ModelField
+	ResponseHeaders  []*ModelField
+	Annotations      []*AnnotationIR
 }
 
 func (r *RestEndpointIR) GetKind() string {
@@ -12,10 +12,15 @@ type Target struct {
 	OutDir   string `json:"outDir"`
 }
 
+type CloudEventsConfig struct {
+	Source string `json:"source"`
+}
+
 type ContractorConfig struct {
-	SourceDir string   `json:"sourceDir"`
-	Extension string   `json:"extension"`
-	Targets   []Target `json:"targets"`
+	SourceDir   string             `json:"sourceDir"`
+	Extension   string             `json:"extension"`
+	Targets     []Target           `json:"targets"`
+	CloudEvents *CloudEventsConfig `json:"cloudEvents"`
 }
 
 func Load(path string) (*ContractorConfig, error) {
@@ -54,5 +59,9 @@ func Load(path string) (*ContractorConfig, error) {
 		}
 	}
 
+	if cfg.CloudEvents != nil && strings.TrimSpace(cfg.CloudEvents.Source) == "" {
+		return nil, fmt.Errorf("config.cloudEvents.source is required")
+	}
+
 	return cfg, nil
 }
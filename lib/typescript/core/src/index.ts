@@ -1,3 +1,19 @@
+export type CustomValidator = (value: any, ...args: any[]) => string | null;
+
+const customValidators: Record<string, CustomValidator> = {};
+
+export const registerValidator = (name: string, validator: CustomValidator) => {
+  customValidators[name] = validator;
+};
+
+export const registerValidators = (
+  validators: Record<string, CustomValidator>,
+) => {
+  for (const [name, validator] of Object.entries(validators)) {
+    registerValidator(name, validator);
+  }
+};
+
 export const Validator = {
   Is: (value: unknown, target: unknown, errorMsg: string) => {
     return value === target ? null : errorMsg;
@@ -25,8 +41,9 @@ export const Validator = {
     return value?.length <= max ? null : errorMsg;
   },
 
-  Matches: (value: unknown, regex: RegExp, errorMsg: string) => {
-    return typeof value === "string" && regex.test(value) ? null : errorMsg;
+  Matches: (value: unknown, regex: RegExp | string, errorMsg: string) => {
+    const pattern = typeof regex === "string" ? new RegExp(regex) : regex;
+    return typeof value === "string" && pattern.test(value) ? null : errorMsg;
   },
   Contains: (value: string, sub: string, errorMsg: string) => {
     return value?.includes(sub) ? null : errorMsg;
@@ -42,6 +59,21 @@ export const Validator = {
     return list.includes(value) ? null : errorMsg;
   },
 
+  MinItems: (value: unknown, min: number, errorMsg: string) => {
+    return Array.isArray(value) && value.length >= min ? null : errorMsg;
+  },
+  MaxItems: (value: unknown, max: number, errorMsg: string) => {
+    return Array.isArray(value) && value.length <= max ? null : errorMsg;
+  },
+  UniqueItems: (value: unknown, errorMsg: string) => {
+    if (!Array.isArray(value)) {
+      return errorMsg;
+    }
+
+    const keys = value.map((item) => JSON.stringify(item));
+    return new Set(keys).size === keys.length ? null : errorMsg;
+  },
+
   IsEmail: (value: string, errorMsg: string) => {
     const emailRegex = /^[^\s@]+@[^\s@]+\.[^\s@]+$/;
     return emailRegex.test(value) ? null : errorMsg;
@@ -98,6 +130,52 @@ export const Validator = {
     const details = validate(value);
     return details && Object.keys(details).length > 0 ? errorMsg : null;
   },
+
+  EqualsField: (value: unknown, other: unknown, errorMsg: string) => {
+    return value === other ? null : errorMsg;
+  },
+  Compare: (
+    value: any,
+    operator: "<" | "<=" | ">" | ">=" | "==" | "!=",
+    other: any,
+    errorMsg: string,
+  ) => {
+    if (value === undefined || value === null || other === undefined || other === null) {
+      return null;
+    }
+
+    switch (operator) {
+      case "<":
+        return value < other ? null : errorMsg;
+      case "<=":
+        return value <= other ? null : errorMsg;
+      case ">":
+        return value > other ? null : errorMsg;
+      case ">=":
+        return value >= other ? null : errorMsg;
+      case "==":
+        return value === other ? null : errorMsg;
+      case "!=":
+        return value !== other ? null : errorMsg;
+      default:
+        return errorMsg;
+    }
+  },
+  OneOf: (values: unknown[], errorMsg: string) => {
+    const present = values.filter(
+      (value) => value !== undefined && value !== null,
+    );
+    return present.length === 1 ? null : errorMsg;
+  },
+
+  Custom: (name: string, value: unknown, ...args: unknown[]) => {
+    const validator = customValidators[name];
+    if (!validator) {
+      throw new Error(`Validator '${name}' is not registered`);
+    }
+
+    return validator(value, ...args);
+  },
 };
 
 export type RestMethod = "GET" | "POST" | "PUT" | "DELETE" | "PATCH" | "OPTION";
@@ -105,8 +183,222 @@ export interface RestMetadata {
   path: string;
   method: RestMethod;
   queries: string[];
+  errors?: string[];
+  headers?: string[];
+  responseHeaders?: string[];
+}
+
+export interface ServiceMetadata {
+  name: string;
+  basePath: string;
+  endpoints: RestMetadata[];
+}
+
+export interface RpcMetadata {
+  name: string;
+  service?: string;
+  errors: string[];
+  rest: RestMetadata;
+}
+
+export interface RestRequest {
+  path: string;
+  query?: URLSearchParams;
+  headers?: Record<string, string>;
+  body?: unknown;
+}
+
+export interface RestResult {
+  status: number;
+  headers?: HeaderSource;
+  body: unknown;
+}
+
+export type RestTransport = (
+  info: RestMetadata,
+  request: RestRequest,
+) => Promise<RestResult>;
+
+export class RestStatusError extends Error {
+  public status: number;
+  public body: unknown;
+
+  constructor(info: RestMetadata, status: number, body: unknown) {
+    super(`Unexpected status ${status} for ${info.method} ${info.path}`);
+    this.name = "RestStatusError";
+    this.status = status;
+    this.body = body;
+  }
+}
+
+export interface StreamMetadata {
+  path: string;
+  queries: string[];
+}
+
+export interface ChannelMetadata {
+  path: string;
+  queries: string[];
+  client: string[];
+  server: string[];
+}
+
+export interface StreamHandlers<T> {
+  onEvent: (event: T) => void;
+  onError?: (error: unknown) => void;
+}
+
+export interface StreamSubscription {
+  close: () => void;
+}
+
+export interface ChannelHandlers<T> {
+  onMessage: (message: T) => void;
+  onOpen?: () => void;
+  onClose?: () => void;
+  onError?: (error: unknown) => void;
 }
 
+export interface Channel<T> {
+  send: (message: T) => void;
+  close: () => void;
+}
+
+export const buildUrl = (
+  baseUrl: string,
+  path: string,
+  query?: URLSearchParams,
+): string => {
+  const search = query ? query.toString() : "";
+  return baseUrl.replace(/\/+$/, "") + path + (search ? `?${search}` : "");
+};
+
+export const openEventStream = <T>(
+  url: string,
+  decode: (data: any) => T,
+  handlers: StreamHandlers<T>,
+): StreamSubscription => {
+  const source = new EventSource(url);
+  source.onmessage = (event) => {
+    try {
+      handlers.onEvent(decode(JSON.parse(event.data)));
+    } catch (error) {
+      handlers.onError?.(error);
+    }
+  };
+  source.onerror = (error) => handlers.onError?.(error);
+
+  return { close: () => source.close() };
+};
+
+export const openChannel = <TSend, TReceive>(
+  url: string,
+  decode: (data: any) => TReceive,
+  handlers: ChannelHandlers<TReceive>,
+): Channel<TSend> => {
+  const socket = new WebSocket(url);
+  const pending: string[] = [];
+
+  socket.onopen = () => {
+    for (const message of pending.splice(0)) {
+      socket.send(message);
+    }
+    handlers.onOpen?.();
+  };
+  socket.onclose = () => handlers.onClose?.();
+  socket.onerror = (error) => handlers.onError?.(error);
+  socket.onmessage = (event) => {
+    try {
+      handlers.onMessage(decode(JSON.parse(String(event.data))));
+    } catch (error) {
+      handlers.onError?.(error);
+    }
+  };
+
+  return {
+    send: (message) => {
+      const encoded = JSON.stringify(message);
+      if (socket.readyState === WebSocket.OPEN) {
+        socket.send(encoded);
+        return;
+      }
+      pending.push(encoded);
+    },
+    close: () => socket.close(),
+  };
+};
+
+export class ParamDecodeError extends Error {
+  public param: string;
+  public details: GeneratedValidationDetails;
+
+  constructor(param: string, message: string) {
+    super(message);
+    this.name = "ParamDecodeError";
+    this.param = param;
+    this.details = { [param]: [message] };
+  }
+}
+
+export const decodeIntParam = (raw: string | null | undefined, name: string): number => {
+  if (raw == null || !/^[-+]?\d+$/.test(raw.trim())) {
+    throw new ParamDecodeError(name, `'${name}' must be an integer`);
+  }
+  return Number.parseInt(raw, 10);
+};
+
+export const decodeFloatParam = (raw: string | null | undefined, name: string): number => {
+  const value = Number(raw);
+  if (raw == null || raw.trim() === "" || Number.isNaN(value)) {
+    throw new ParamDecodeError(name, `'${name}' must be a number`);
+  }
+  return value;
+};
+
+export const decodeBoolParam = (raw: string | null | undefined, name: string): boolean => {
+  if (raw === "true") {
+    return true;
+  }
+  if (raw === "false") {
+    return false;
+  }
+  throw new ParamDecodeError(name, `'${name}' must be true or false`);
+};
+
+export const decodeEnumParam = <T>(
+  value: unknown,
+  members: Record<string, unknown>,
+  name: string,
+): T => {
+  if (!Object.values(members).includes(value)) {
+    throw new ParamDecodeError(name, `'${name}' has an unknown value '${String(value)}'`);
+  }
+  return value as T;
+};
+
+export type HeaderSource =
+  | Headers
+  | Record<string, string | string[] | undefined>;
+
+export const readHeader = (
+  headers: HeaderSource,
+  name: string,
+): string | undefined => {
+  if (typeof Headers !== "undefined" && headers instanceof Headers) {
+    return headers.get(name) ?? undefined;
+  }
+
+  const key = Object.keys(headers).find(
+    (item) => item.toLowerCase() === name.toLowerCase(),
+  );
+  if (key === undefined) {
+    return undefined;
+  }
+
+  const value = (headers as Record<string, string | string[] | undefined>)[key];
+  return Array.isArray(value) ? value[0] : value;
+};
+
 export type RestRequestBody<T> = T;
 export type RestResponseBody<T> = T;
 export type GeneratedErrorConstructor = new () => Error;
@@ -117,7 +409,112 @@ export type GeneratedErrorConstructorMap = Record<
 export type GeneratedValidationDetails = Record<string, string[]>;
 export interface EventMetadata {
   name: string;
-  method: string;
+  topic?: string;
+  key?: string;
+  headers?: string[];
+  version: number;
+  method?: string;
 }
 
 export type EventPayload<T> = T;
+
+export interface CloudEvent<T> {
+  specversion: "1.0";
+  id: string;
+  source: string;
+  type: string;
+  datacontenttype?: string;
+  dataschema?: string;
+  subject?: string;
+  time?: string;
+  dataversion?: number;
+  data: T;
+}
+
+export interface CloudEventAttributes {
+  type: string;
+  source: string;
+  dataschema?: string;
+  dataversion?: number;
+}
+
+export interface CloudEventOptions {
+  id?: string;
+  subject?: string;
+  time?: string;
+}
+
+export class CloudEventValidationError extends Error {
+  public type: string;
+  public details: GeneratedValidationDetails;
+
+  constructor(type: string, details: GeneratedValidationDetails) {
+    super(`Invalid data for CloudEvent '${type}'`);
+    this.name = "CloudEventValidationError";
+    this.type = type;
+    this.details = details;
+  }
+}
+
+const validateCloudEventData = (
+  type: string,
+  data: any,
+  validate?: (data: any) => GeneratedValidationDetails,
+) => {
+  const details = validate ? validate(data) : {};
+  if (Object.keys(details).length > 0) {
+    throw new CloudEventValidationError(type, details);
+  }
+};
+
+const newCloudEventId = (): string =>
+  typeof crypto !== "undefined" && typeof crypto.randomUUID === "function"
+    ? crypto.randomUUID()
+    : `${Date.now().toString(36)}-${Math.random().toString(36).slice(2)}`;
+
+export const createCloudEvent = <T>(
+  attributes: CloudEventAttributes,
+  data: T,
+  validate?: (data: any) => GeneratedValidationDetails,
+  options: CloudEventOptions = {},
+): CloudEvent<T> => {
+  validateCloudEventData(attributes.type, data, validate);
+
+  return {
+    specversion: "1.0",
+    id: options.id ?? newCloudEventId(),
+    source: attributes.source,
+    type: attributes.type,
+    datacontenttype: "application/json",
+    ...(attributes.dataschema ? { dataschema: attributes.dataschema } : {}),
+    ...(options.subject ? { subject: options.subject } : {}),
+    time: options.time ?? new Date().toISOString(),
+    ...(attributes.dataversion !== undefined ? { dataversion: attributes.dataversion } : {}),
+    data,
+  };
+};
+
+export const readCloudEvent = <T>(
+  envelope: any,
+  attributes: CloudEventAttributes,
+  decode?: (data: any) => T,
+  validate?: (data: any) => GeneratedValidationDetails,
+): CloudEvent<T> => {
+  if (envelope?.specversion !== "1.0") {
+    throw new Error(`Unsupported CloudEvents specversion '${envelope?.specversion}'`);
+  }
+
+  if (envelope.type !== attributes.type) {
+    throw new Error(`Expected CloudEvent type '${attributes.type}' but got '${envelope.type}'`);
+  }
+
+  if (attributes.dataversion !== undefined && Number(envelope.dataversion) !== attributes.dataversion) {
+    throw new Error(
+      `Expected CloudEvent '${attributes.type}' dataversion ${attributes.dataversion} but got '${envelope.dataversion}'`,
+    );
+  }
+
+  validateCloudEventData(attributes.type, envelope.data, validate);
+
+  return { ...envelope, data: decode ? decode(envelope.data) : envelope.data };
+};
@@ -34,6 +34,9 @@ func (n *IdentNode) GetType() string {
 type ModelDeclNode struct {
 	Name        *IdentNode
 	Generics    []*TypeVarNode
+	Extends     *TypeDeclNode
+	Spreads     []*SpreadFieldsNode
+	Derived     *ModelExprNode
 	Fields      []*ModelFieldDeclNode
 	Annotations []*AnnotationNode
 	Loc         *Location
@@ -48,9 +51,10 @@ func (n *ModelDeclNode) GetType() string {
 }
 
 type EnumDeclNode struct {
-	Name    *IdentNode
-	Members []*IdentNode
-	Loc     *Location
+	Name        *IdentNode
+	Members     []*EnumMemberNode
+	Annotations []*AnnotationNode
+	Loc         *Location
 }
 
 func (n *EnumDeclNode) GetLocation() *Location {
@@ -61,6 +65,109 @@ func (n *EnumDeclNode) GetType() string {
 	return "EnumDecl"
 }
 
+type EnumMemberNode struct {
+	Name        *IdentNode
+	Value       ASTValueNode
+	Annotations []*AnnotationNode
+	Doc         string
+	Loc         *Location
+}
+
+func (n *EnumMemberNode) GetLocation() *Location {
+	return n.Loc
+}
+
+func (n *EnumMemberNode) GetType() string {
+	return "EnumMember"
+}
+
+type StreamDeclNode struct {
+	Name        *IdentNode
+	PathValue   ASTValueNode
+	Params      *FieldBlockNode
+	Queries     *FieldBlockNode
+	EventType   *TypeDeclNode
+	Annotations []*AnnotationNode
+	Loc         *Location
+}
+
+func (n *StreamDeclNode) GetLocation() *Location {
+	return n.Loc
+}
+
+func (n *StreamDeclNode) GetType() string {
+	return "StreamDecl"
+}
+
+type ChannelDeclNode struct {
+	Name           *IdentNode
+	PathValue      ASTValueNode
+	Params         *FieldBlockNode
+	Queries        *FieldBlockNode
+	ClientMessages []*TypeDeclNode
+	ServerMessages []*TypeDeclNode
+	Annotations    []*AnnotationNode
+	Loc            *Location
+}
+
+func (n *ChannelDeclNode) GetLocation() *Location {
+	return n.Loc
+}
+
+func (n *ChannelDeclNode) GetType() string {
+	return "ChannelDecl"
+}
+
+type RpcDeclNode struct {
+	Name         *IdentNode
+	RequestType  *TypeDeclNode
+	ResponseType *TypeDeclNode
+	Throws       []*IdentNode
+	Annotations  []*AnnotationNode
+	Service      *ServiceDeclNode
+	Loc          *Location
+}
+
+func (n *RpcDeclNode) GetLocation() *Location {
+	return n.Loc
+}
+
+func (n *RpcDeclNode) GetType() string {
+	return "RpcDecl"
+}
+
+type ServiceDeclNode struct {
+	Name          *IdentNode
+	BasePathValue ASTValueNode
+	Headers       *FieldBlockNode
+	Rests         []*RestDeclNode
+	Rpcs          []*RpcDeclNode
+	Annotations   []*AnnotationNode
+	Loc           *Location
+}
+
+func (n *ServiceDeclNode) GetLocation() *Location {
+	return n.Loc
+}
+
+func (n *ServiceDeclNode) GetType() string {
+	return "ServiceDecl"
+}
+
+type RestResponseNode struct {
+	Status *NumberValueNode
+	Type   *TypeDeclNode
+	Loc    *Location
+}
+
+func (n *RestResponseNode) GetLocation() *Location {
+	return n.Loc
+}
+
+func (n *RestResponseNode) GetType() string {
+	return "RestResponse"
+}
+
 type RestDeclNode struct {
 	Name             *IdentNode
 	MethodValue      ASTValueNode
@@ -68,6 +175,15 @@ type RestDeclNode struct {
 	RequestBodyType  *TypeDeclNode
 	ResponseBodyType *TypeDeclNode
 	QueriesValue     ASTValueNode
+	ErrorsValue      ASTValueNode
+	Queries          *FieldBlockNode
+	Params           *FieldBlockNode
+	Responses        []*RestResponseNode
+	ResponsesLoc     *Location
+	Headers          *FieldBlockNode
+	ResponseHeaders  *FieldBlockNode
+	Annotations      []*AnnotationNode
+	Service          *ServiceDeclNode
 	Loc              *Location
 }
 
@@ -80,10 +196,16 @@ func (n *RestDeclNode) GetType() string {
 }
 
 type EventDeclNode struct {
-	Name        *IdentNode
-	PayloadType *TypeDeclNode
-	NameValue   ASTValueNode
-	Loc         *Location
+	Name            *IdentNode
+	PayloadType     *TypeDeclNode
+	NameValue       ASTValueNode
+	TopicValue      ASTValueNode
+	KeyValue        ASTValueNode
+	VersionValue    ASTValueNode
+	DataSchemaValue ASTValueNode
+	Headers         *FieldBlockNode
+	Annotations     []*AnnotationNode
+	Loc             *Location
 }
 
 func (n *EventDeclNode) GetLocation() *Location {
@@ -100,6 +222,7 @@ type ErrorDeclNode struct {
 	MessageValue ASTValueNode
 	ScopeValue   ASTValueNode
 	StatusValue  ASTValueNode
+	Annotations  []*AnnotationNode
 	Loc          *Location
 }
 
@@ -111,14 +234,75 @@ func (n *ErrorDeclNode) GetType() string {
 	return "ErrorDecl"
 }
 
-type ModelFieldDeclNode struct {
+type ConstDeclNode struct {
 	Name        *IdentNode
 	Type        *TypeDeclNode
-	Optional    bool
+	Value       ASTValueNode
 	Annotations []*AnnotationNode
 	Loc         *Location
 }
 
+func (n *ConstDeclNode) GetLocation() *Location {
+	return n.Loc
+}
+
+func (n *ConstDeclNode) GetType() string {
+	return "ConstDecl"
+}
+
+type AnnotationDeclNode struct {
+	Name        *IdentNode
+	Params      []*AnnotationParamNode
+	Targets     []*IdentNode
+	IsValidator bool
+	ForTypes    []*TypeDeclNode
+	Loc         *Location
+}
+
+func (n *AnnotationDeclNode) GetLocation() *Location {
+	return n.Loc
+}
+
+func (n *AnnotationDeclNode) GetType() string {
+	return "AnnotationDecl"
+}
+
+type AnnotationParamNode struct {
+	Name *IdentNode
+	Type *TypeDeclNode
+	Loc  *Location
+}
+
+func (n *AnnotationParamNode) GetLocation() *Location {
+	return n.Loc
+}
+
+func (n *AnnotationParamNode) GetType() string {
+	return "AnnotationParam"
+}
+
+type FieldBlockNode struct {
+	Fields []*ModelFieldDeclNode
+	Loc    *Location
+}
+
+func (n *FieldBlockNode) GetLocation() *Location {
+	return n.Loc
+}
+
+func (n *FieldBlockNode) GetType() string {
+	return "FieldBlock"
+}
+
+type ModelFieldDeclNode struct {
+	Name         *IdentNode
+	Type         *TypeDeclNode
+	Optional     bool
+	DefaultValue ASTValueNode
+	Annotations  []*AnnotationNode
+	Loc          *Location
+}
+
 func (n *ModelFieldDeclNode) GetLocation() *Location {
 	return n.Loc
 }
@@ -127,11 +311,42 @@ func (n *ModelFieldDeclNode) GetType() string {
 	return "ModelFieldDecl"
 }
 
-type TypeVarNode struct {
-	Name *IdentNode
+type ModelExprNode struct {
+	Utility *IdentNode
+	Operand *ModelExprNode
+	Keys    []*StringValueNode
+	Ref     *TypeDeclNode
+	Loc     *Location
+}
+
+func (n *ModelExprNode) GetLocation() *Location {
+	return n.Loc
+}
+
+func (n *ModelExprNode) GetType() string {
+	return "ModelExpr"
+}
+
+type SpreadFieldsNode struct {
+	Type *TypeDeclNode
 	Loc  *Location
 }
 
+func (n *SpreadFieldsNode) GetLocation() *Location {
+	return n.Loc
+}
+
+func (n *SpreadFieldsNode) GetType() string {
+	return "SpreadFields"
+}
+
+type TypeVarNode struct {
+	Name       *IdentNode
+	Constraint *TypeDeclNode
+	Default    *TypeDeclNode
+	Loc        *Location
+}
+
 func (n *TypeVarNode) GetLocation() *Location {
 	return n.Loc
 }
@@ -256,3 +471,37 @@ func (n *NullValueNode) GetType() string {
 func (n *NullValueNode) GetKind() string {
 	return "Null"
 }
+
+type AnnotationValueNode struct {
+	Annotation *AnnotationNode
+	Loc        *Location
+}
+
+func (n *AnnotationValueNode) GetLocation() *Location {
+	return n.Loc
+}
+
+func (n *AnnotationValueNode) GetType() string {
+	return "AnnotationValue"
+}
+
+func (n *AnnotationValueNode) GetKind() string {
+	return "Annotation"
+}
+
+type ReferenceValueNode struct {
+	Name *IdentNode
+	Loc  *Location
+}
+
+func (n *ReferenceValueNode) GetLocation() *Location {
+	return n.Loc
+}
+
+func (n *ReferenceValueNode) GetType() string {
+	return "ReferenceValue"
+}
+
+func (n *ReferenceValueNode) GetKind() string {
+	return "Reference"
+}
@@ -0,0 +1,401 @@
+package parser
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/smtdfc/contractor/exception"
+)
+
+func checkSource(t *testing.T, code string) (*TypeChecker, exception.IException) {
+	t.Helper()
+
+	tokens, err := NewLexer("test.contract").Start(code)
+	if err != nil {
+		return nil, err
+	}
+
+	ast, err := NewParser("test.contract", tokens).Parse()
+	if err != nil {
+		return nil, err
+	}
+
+	checker := NewTypeChecker()
+	return checker, checker.Check(ast)
+}
+
+func expectCheckError(t *testing.T, code string, want string) {
+	t.Helper()
+
+	_, err := checkSource(t, code)
+	if err == nil {
+		t.Fatalf("expected error containing %q, got none", want)
+	}
+	if !strings.Contains(err.GetMsg(), want) {
+		t.Fatalf("expected error containing %q, got %q", want, err.GetMsg())
+	}
+}
+
+func expectCheckOK(t *testing.T, code string) *TypeChecker {
+	t.Helper()
+
+	checker, err := checkSource(t, code)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err.GetMsg())
+	}
+	return checker
+}
+
+const orderStatusEnum = `
+enum OrderStatus {
+  PENDING
+  DONE
+}
+`
+
+func TestValidatorFieldTypeErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		field string
+		want  string
+	}{
+		{"min on string", `@Min(1, "too small") value: String`, "Validator 'Min' cannot be used on field of type 'String'"},
+		{"max length on bool", `@MaxLength(3, "too long") value: Bool`, "Validator 'MaxLength' cannot be used on field of type 'Bool'"},
+		{"matches on int", `@Matches("^a$", "bad") value: Int`, "Validator 'Matches' cannot be used on field of type 'Int'"},
+		{"range min above max", `@Range(10, 1, "out of range") value: Int`, "Annotation 'Range' min (10) must not be greater than max (1)"},
+		{"in value type", `@In([1, 2], "not allowed") value: String`, "Annotation 'In' value 1 does not match field type 'String'"},
+		{"in unknown enum member", `@In([CANCELLED], "not allowed") status: OrderStatus`, "Annotation 'In' value CANCELLED does not match field type 'OrderStatus'"},
+		{"each on items", `@Each(@Min(1, "too small")) values: Array<String>`, "Validator 'Min' cannot be used on items of type 'String'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expectCheckError(t, orderStatusEnum+"model Order {\n  "+tt.field+"\n}\n", tt.want)
+		})
+	}
+}
+
+func TestValidatorFieldTypeAccepted(t *testing.T) {
+	tests := []struct {
+		name  string
+		field string
+	}{
+		{"in enum member", `@In([PENDING, DONE], "not allowed") status: OrderStatus`},
+		{"in enum value literal", `@In(["PENDING"], "not allowed") status: OrderStatus`},
+		{"length on array", `@MaxLength(3, "too many") values: Array<String>`},
+		{"range on float", `@Range(0, 1.5, "out of range") ratio: Float`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expectCheckOK(t, orderStatusEnum+"model Order {\n  "+tt.field+"\n}\n")
+		})
+	}
+}
+
+func TestMatchesPatternErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		want    string
+	}{
+		{"unbalanced group", `(`, "Annotation 'Matches' pattern is not a valid regular expression"},
+		{"bad repetition", `a**`, "Annotation 'Matches' pattern is not a valid regular expression"},
+		{"invalid after lookahead", `(?=a)[`, "Annotation 'Matches' pattern is not a valid regular expression"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expectCheckError(t, "model Account {\n  @Matches(\""+tt.pattern+"\", \"bad\") password: String\n}\n", tt.want)
+		})
+	}
+}
+
+func TestMatchesPatternOutsideRE2Warns(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		warns   bool
+	}{
+		{"lookahead", `^(?=.*\\d).+$`, true},
+		{"lookbehind", `(?<!x)y`, true},
+		{"backreference", `(a)\\1`, true},
+		{"unicode escape", `^\\u00e9$`, false},
+		{"plain", `^[a-z]+$`, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checker := expectCheckOK(t, "model Account {\n  @Matches(\""+tt.pattern+"\", \"bad\") password: String\n}\n")
+
+			warned := false
+			for _, warning := range checker.GetWarnings() {
+				if strings.Contains(warning.Msg, "Annotation 'Matches' pattern uses lookaround or backreferences") {
+					warned = true
+				}
+			}
+			if warned != tt.warns {
+				t.Fatalf("expected warning %v, got %v", tt.warns, checker.GetWarnings())
+			}
+		})
+	}
+}
+
+func TestRestQueryErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		queries string
+		want    string
+	}{
+		{"duplicate", "page: Int\n    page: Int", "Query parameter 'page' is already declared"},
+		{"model type", "filter: Filter", "Query parameter 'filter' must be a String, Int, Float, Bool, enum or an Array of them"},
+		{"nested array", "ids: Array<Array<Int>>", "Query parameter 'ids' must be a String, Int, Float, Bool, enum or an Array of them"},
+		{"validator type", "@Min(1, \"too small\") q: String", "Validator 'Min' cannot be used on field of type 'String'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expectCheckError(t, `
+model Filter {
+  q: String
+}
+model Orders {
+  total: Int
+}
+rest ListOrders {
+  method: "GET"
+  path: "/orders"
+  queries {
+    `+tt.queries+`
+  }
+  responseBody: Orders
+}
+`, tt.want)
+		})
+	}
+}
+
+func TestRestResponsesErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		responses string
+		want      string
+	}{
+		{"status below range", "99: User", "Response status '99' must be an integer between 100 and 599"},
+		{"status above range", "600: User", "Response status '600' must be an integer between 100 and 599"},
+		{"duplicate status", "200: User, 200: Job", "Response status 200 is already declared"},
+		{"builtin body", "200: String", "Response 200 must be a user-defined type"},
+		{"no content body", "204: User", "Response 204 cannot have a body"},
+		{"empty block", "", "Rest property 'responses' must declare at least one status"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expectCheckError(t, `
+model User {
+  id: String
+}
+model Job {
+  id: String
+}
+rest CreateUser {
+  method: "POST"
+  path: "/users"
+  responses { `+tt.responses+` }
+}
+`, tt.want)
+		})
+	}
+}
+
+func TestRestResponsesWithResponseBody(t *testing.T) {
+	expectCheckError(t, `
+model User {
+  id: String
+}
+rest CreateUser {
+  method: "POST"
+  path: "/users"
+  responseBody: User
+  responses { 201: User }
+}
+`, "Rest properties 'responseBody' and 'responses' cannot be used together")
+}
+
+func TestRestResponsesAccepted(t *testing.T) {
+	expectCheckOK(t, `
+model User {
+  id: String
+}
+model Job {
+  id: String
+}
+rest CreateUser {
+  method: "POST"
+  path: "/users"
+  responses { 201: User, 202: Job, 204: Null }
+}
+`)
+}
+
+func userCreatedEvent(version string, wireName string) string {
+	return `
+event UserCreated ` + version + ` {
+  name: "` + wireName + `"
+  payload: User
+}
+`
+}
+
+func TestEventVersionErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		events string
+		want   string
+	}{
+		{"duplicate version", userCreatedEvent("v1", "user.created") + userCreatedEvent("v1", "user.created"), "Event 'UserCreated' v1 is already declared"},
+		{"missing version", userCreatedEvent("v1", "user.created") + userCreatedEvent("v3", "user.created"), "Event 'UserCreated' versions must be contiguous, v2 is missing"},
+		{"wire name mismatch", userCreatedEvent("v1", "user.created") + userCreatedEvent("v2", "users.created"), "Event 'UserCreated' v2 name 'users.created' does not match 'user.created' declared by v1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expectCheckError(t, "model User {\n  id: String\n}\n"+tt.events, tt.want)
+		})
+	}
+}
+
+func TestEventVersionsAccepted(t *testing.T) {
+	expectCheckOK(t, "model User {\n  id: String\n}\n"+
+		userCreatedEvent("v2", "user.created")+
+		userCreatedEvent("v1", "user.created")+
+		userCreatedEvent("v3", "user.created"))
+}
+
+const conditionalAccount = `
+enum Kind {
+  PERSON
+  COMPANY
+}
+model Account {
+  kind: Kind
+  @When("kind", COMPANY)
+  @NotNull("required for companies")
+  vat?: String
+  name: String
+}
+`
+
+func TestDerivedModelDropsWhenField(t *testing.T) {
+	tests := []struct {
+		name    string
+		derived string
+	}{
+		{"omit", `model Public = Omit<Account, "kind">`},
+		{"pick", `model Public = Pick<Account, "vat", "name">`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expectCheckError(t, conditionalAccount+tt.derived+"\n", "Model 'Public' keeps field 'vat' but not field 'kind' that its 'When' condition refers to")
+		})
+	}
+}
+
+func TestDerivedModelKeepsWhenField(t *testing.T) {
+	tests := []struct {
+		name    string
+		derived string
+	}{
+		{"omit", `model Public = Omit<Account, "name">`},
+		{"pick", `model Public = Pick<Account, "kind", "vat">`},
+		{"pick without condition", `model Public = Pick<Account, "name">`},
+		{"partial", `model Public = Partial<Account>`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expectCheckOK(t, conditionalAccount+tt.derived+"\n")
+		})
+	}
+}
+
+func TestWhenConditionErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		field string
+		want  string
+	}{
+		{"repeated", `@When("n", 1) @When("n", 2) @NotNull("x") vat?: String`, "Field 'vat' has more than one 'When' annotation"},
+		{"after validators", `@NotNull("x") @When("kind", COMPANY) vat?: String`, "Annotation 'When' on field 'vat' is not followed by any validator"},
+		{"without validators", `@When("kind", COMPANY) vat?: String`, "Annotation 'When' on field 'vat' is not followed by any validator"},
+		{"unknown field", `@When("missing", 1) @NotNull("x") vat?: String`, "Annotation 'When' refers to unknown field 'missing'"},
+		{"foreign enum member", `@When("kind", ARCHIVED) @NotNull("x") vat?: String`, "'ARCHIVED' is not a member of enum 'Kind' of field 'kind'"},
+		{"undefined enum member", `@When("kind", CANCELLED) @NotNull("x") vat?: String`, "'CANCELLED' is not a member of enum 'Kind' of field 'kind'"},
+		{"undefined constant", `@When("n", FOO) @NotNull("x") vat?: String`, "Constant 'FOO' is not defined"},
+		{"value type", `@When("n", "one") @NotNull("x") vat?: String`, "Annotation 'When' value \"one\" does not match type 'Int' of field 'n'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expectCheckError(t, `
+enum Kind {
+  PERSON
+  COMPANY
+}
+enum Status {
+  ARCHIVED
+}
+model Account {
+  kind: Kind
+  n: Int
+  `+tt.field+`
+}
+`, tt.want)
+		})
+	}
+}
+
+func TestWhenConditionInQueryBlock(t *testing.T) {
+	expectCheckError(t, `
+model Orders {
+  total: Int
+}
+rest ListOrders {
+  method: "GET"
+  path: "/orders"
+  queries {
+    n?: Int
+    @When("n", FOO) @NotNull("x") q?: String
+  }
+  responseBody: Orders
+}
+`, "Constant 'FOO' is not defined")
+}
+
+func TestEventDataSchemaErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{"not a string", `1`, "Event property 'dataschema' must be a string literal"},
+		{"relative", `"schemas/user.json"`, "Event property 'dataschema' must be an absolute URI"},
+		{"empty", `""`, "Event property 'dataschema' must be an absolute URI"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expectCheckError(t, `
+model User {
+  id: String
+}
+event UserCreated {
+  name: "user.created"
+  payload: User
+  dataschema: `+tt.value+`
+}
+`, tt.want)
+		})
+	}
+}
@@ -142,6 +142,52 @@ func (l *Lexer) ReadString(scanner *Scanner) (*Token, exception.IException) {
 	)
 }
 
+func (l *Lexer) ReadComment(scanner *Scanner) (*Token, exception.IException) {
+	startPos := scanner.GetPosition()
+	scanner.Next()
+
+	if scanner.Current == '*' {
+		scanner.Next()
+		for scanner.Current != nullRune {
+			if scanner.Current == '*' && scanner.Peek() == '/' {
+				scanner.Next()
+				scanner.Next()
+				return nil, nil
+			}
+			scanner.Next()
+		}
+
+		return nil, exception.NewSyntaxException(
+			"Unterminated block comment",
+			NewLocation(l.File, startPos, startPos),
+		)
+	}
+
+	scanner.Next()
+	isDoc := scanner.Current == '/'
+	if isDoc {
+		scanner.Next()
+	}
+
+	var text strings.Builder
+	lastPos := scanner.GetPosition()
+	for scanner.Current != nullRune && scanner.Current != '\n' {
+		text.WriteRune(scanner.Current)
+		lastPos = scanner.GetPosition()
+		scanner.Next()
+	}
+
+	if !isDoc {
+		return nil, nil
+	}
+
+	return NewToken(
+		TT_DOC,
+		strings.TrimSpace(text.String()),
+		NewLocation(l.File, startPos, lastPos),
+	), nil
+}
+
 func (l *Lexer) Start(code string) (TokenList, exception.IException) {
 	tokens := make(TokenList, 0)
 	scanner := NewScanner(code)
@@ -162,7 +208,18 @@ func (l *Lexer) Start(code string) (TokenList, exception.IException) {
 			tokens = append(tokens, token)
 			continue
 
-		case IsDigit(scanner.Current):
+		case scanner.Current == '/' && (scanner.Peek() == '/' || scanner.Peek() == '*'):
+			token, err := l.ReadComment(scanner)
+			if err != nil {
+				return nil, err
+			}
+
+			if token != nil {
+				tokens = append(tokens, token)
+			}
+			continue
+
+		case IsDigit(scanner.Current) || (scanner.Current == '-' && IsDigit(scanner.Peek())):
 			token, err := l.ReadNumber(scanner)
 			if err != nil {
 				return nil, err
@@ -250,6 +307,18 @@ func (l *Lexer) Start(code string) (TokenList, exception.IException) {
 			scanner.Next()
 			continue
 
+		case scanner.Current == '.' && scanner.Peek() == '.' && scanner.PeekAt(1) == '.':
+			startPos := scanner.GetPosition()
+			scanner.Next()
+			scanner.Next()
+			tokens = append(tokens, NewToken(
+				TT_SPREAD,
+				"...",
+				NewLocation(l.File, startPos, scanner.GetPosition()),
+			))
+			scanner.Next()
+			continue
+
 		case scanner.Current == '.':
 			currentPos := scanner.GetPosition()
 			tokens = append(tokens, NewToken(
@@ -310,6 +379,16 @@ func (l *Lexer) Start(code string) (TokenList, exception.IException) {
 			scanner.Next()
 			continue
 
+		case scanner.Current == '=':
+			currentPos := scanner.GetPosition()
+			tokens = append(tokens, NewToken(
+				TT_OP,
+				"=",
+				NewLocation(l.File, currentPos, currentPos),
+			))
+			scanner.Next()
+			continue
+
 		case scanner.Current == '\n':
 			currentPos := scanner.GetPosition()
 			tokens = append(tokens, NewToken(
@@ -336,10 +415,36 @@ func (l *Lexer) Start(code string) (TokenList, exception.IException) {
 
 	tokens = append(tokens, NewToken(TT_EOF, "", NewLocation(l.File, scanner.GetPosition(), scanner.GetPosition())))
 
-	return tokens, nil
+	return attachDocComments(tokens), nil
 
 }
 
 func NewLexer(file string) *Lexer {
 	return &Lexer{File: file}
 }
+
+func attachDocComments(tokens TokenList) TokenList {
+	result := make(TokenList, 0, len(tokens))
+	docs := make([]string, 0)
+
+	for _, token := range tokens {
+		if token.MatchType(TT_DOC) {
+			docs = append(docs, token.Value)
+			continue
+		}
+
+		if token.MatchType(TT_NEWLINE) {
+			result = append(result, token)
+			continue
+		}
+
+		if len(docs) > 0 {
+			token.Doc = strings.Join(docs, "\n")
+			docs = docs[:0]
+		}
+
+		result = append(result, token)
+	}
+
+	return result
+}
@@ -107,12 +107,157 @@ func (p *Parser) ParseTypeDecl() (*TypeDeclNode, exception.IException) {
 	return &typeNode, nil
 }
 
+func isRestBlockProperty(key string) bool {
+	switch key {
+	case "params", "queries", "headers", "responseHeaders", "responses":
+		return true
+	}
+	return false
+}
+
+func (p *Parser) ParseFieldBlock(allowQuotedNames bool) (*FieldBlockNode, exception.IException) {
+	if p.Current == nil || !p.Current.MatchType(TT_LBRACE) {
+		if p.Current == nil {
+			return nil, exception.NewSyntaxException("Expected {", p.Tokens[len(p.Tokens)-1].Loc)
+		}
+		return nil, exception.NewSyntaxException("Expected {", p.Current.Loc)
+	}
+
+	start := p.Current.Loc
+	block := &FieldBlockNode{Fields: make([]*ModelFieldDeclNode, 0)}
+	p.Next()
+	p.SkipNewLine()
+
+	for p.Current != nil && !p.Current.MatchType(TT_RBRACE) && !p.Current.MatchType(TT_EOF) {
+		if p.Current.MatchType(TT_NEWLINE) {
+			p.SkipNewLine()
+			continue
+		}
+
+		fieldAnnotations := make([]*AnnotationNode, 0)
+		for p.Current != nil && p.Current.MatchType(TT_DECORATOR) {
+			annotation, err := p.ParseAnnotation()
+			if err != nil {
+				return nil, err
+			}
+			fieldAnnotations = append(fieldAnnotations, annotation)
+			p.SkipNewLine()
+		}
+
+		isQuotedName := allowQuotedNames && p.Current != nil && p.Current.MatchType(TT_STRING)
+		if p.Current == nil || (!p.Current.MatchType(TT_IDENT) && !isQuotedName) {
+			if p.Current == nil {
+				return nil, exception.NewSyntaxException("Expected field name", p.Tokens[len(p.Tokens)-1].Loc)
+			}
+			if len(fieldAnnotations) > 0 {
+				return nil, exception.NewSyntaxException("Annotation must be followed by a field", fieldAnnotations[len(fieldAnnotations)-1].Loc)
+			}
+			return nil, exception.NewSyntaxException("Expected field name", p.Current.Loc)
+		}
+
+		field, err := p.ParseModelFieldDecl()
+		if err != nil {
+			return nil, err
+		}
+		for p.Current != nil && p.Current.MatchType(TT_DECORATOR) {
+			annotation, err := p.ParseAnnotation()
+			if err != nil {
+				return nil, err
+			}
+			fieldAnnotations = append(fieldAnnotations, annotation)
+		}
+		field.Annotations = append(field.Annotations, fieldAnnotations...)
+		block.Fields = append(block.Fields, field)
+
+		if p.Current != nil && p.Current.MatchType(TT_COMMA) {
+			p.Next()
+		}
+
+		p.SkipNewLine()
+	}
+
+	if p.Current == nil || !p.Current.MatchType(TT_RBRACE) {
+		if p.Current == nil {
+			return nil, exception.NewSyntaxException("Expected } at the end of block", p.Tokens[len(p.Tokens)-1].Loc)
+		}
+		return nil, exception.NewSyntaxException("Expected } at the end of block", p.Current.Loc)
+	}
+
+	block.Loc = NewLocation(start.File, start.Start, p.Current.Loc.End)
+	p.Next()
+
+	return block, nil
+}
+
+func (p *Parser) ParseRestResponses() ([]*RestResponseNode, exception.IException) {
+	if p.Current == nil || !p.Current.MatchType(TT_LBRACE) {
+		if p.Current == nil {
+			return nil, exception.NewSyntaxException("Expected {", p.Tokens[len(p.Tokens)-1].Loc)
+		}
+		return nil, exception.NewSyntaxException("Expected {", p.Current.Loc)
+	}
+
+	responses := make([]*RestResponseNode, 0)
+	p.Next()
+	p.SkipNewLine()
+
+	for p.Current != nil && !p.Current.MatchType(TT_RBRACE) && !p.Current.MatchType(TT_EOF) {
+		if p.Current.MatchType(TT_NEWLINE) {
+			p.SkipNewLine()
+			continue
+		}
+
+		if !p.Current.MatchType(TT_NUMBER) {
+			return nil, exception.NewSyntaxException("Expected status code in responses", p.Current.Loc)
+		}
+
+		start := p.Current.Loc
+		status := &NumberValueNode{Value: p.Current.Value, Loc: p.Current.Loc.Copy()}
+		p.Next()
+
+		if p.Current == nil || !p.Current.MatchType(TT_COLON) {
+			if p.Current == nil {
+				return nil, exception.NewSyntaxException("Expected ':' after status code", p.Tokens[len(p.Tokens)-1].Loc)
+			}
+			return nil, exception.NewSyntaxException("Expected ':' after status code", p.Current.Loc)
+		}
+		p.Next()
+
+		typeNode, err := p.ParseTypeDecl()
+		if err != nil {
+			return nil, err
+		}
+
+		responses = append(responses, &RestResponseNode{
+			Status: status,
+			Type:   typeNode,
+			Loc:    NewLocation(start.File, start.Start, typeNode.Loc.End),
+		})
+
+		if p.Current != nil && p.Current.MatchType(TT_COMMA) {
+			p.Next()
+		}
+
+		p.SkipNewLine()
+	}
+
+	if p.Current == nil || !p.Current.MatchType(TT_RBRACE) {
+		if p.Current == nil {
+			return nil, exception.NewSyntaxException("Expected } at the end of responses", p.Tokens[len(p.Tokens)-1].Loc)
+		}
+		return nil, exception.NewSyntaxException("Expected } at the end of responses", p.Current.Loc)
+	}
+	p.Next()
+
+	return responses, nil
+}
+
 func (p *Parser) ParseModelFieldDecl() (*ModelFieldDeclNode, exception.IException) {
 	var start, end *Location
 	start = p.Current.Loc
 	field := ModelFieldDeclNode{}
 
-	if p.Current.MatchType(TT_IDENT) {
+	if p.Current.MatchType(TT_IDENT) || p.Current.MatchType(TT_STRING) {
 		field.Name = &IdentNode{Value: p.Current.Value, Loc: p.Current.Loc.Copy()}
 		p.Next()
 	} else {
@@ -143,6 +288,18 @@ func (p *Parser) ParseModelFieldDecl() (*ModelFieldDeclNode, exception.IExceptio
 
 	field.Type = typeNode
 	end = typeNode.Loc
+
+	if p.Current != nil && p.Current.Match(TT_OP, "=") {
+		p.Next()
+		value, err := p.ParseValue()
+		if err != nil {
+			return nil, err
+		}
+
+		field.DefaultValue = value
+		end = value.GetLocation()
+	}
+
 	field.Loc = NewLocation(start.File, start.Start, end.End)
 	return &field, nil
 }
@@ -153,6 +310,7 @@ func (p *Parser) ParseModelDecl() (*ModelDeclNode, exception.IException) {
 	model := ModelDeclNode{
 		Fields:      make([]*ModelFieldDeclNode, 0),
 		Generics:    make([]*TypeVarNode, 0),
+		Spreads:     make([]*SpreadFieldsNode, 0),
 		Annotations: make([]*AnnotationNode, 0),
 	}
 
@@ -170,11 +328,33 @@ func (p *Parser) ParseModelDecl() (*ModelDeclNode, exception.IException) {
 		p.Next()
 		for !p.Current.Match(TT_OP, ">") && !p.Current.MatchType(TT_EOF) {
 			if p.Current.MatchType(TT_IDENT) {
-				generics = append(generics, &TypeVarNode{
+				typeVar := &TypeVarNode{
 					Name: &IdentNode{Value: p.Current.Value, Loc: p.Current.Loc.Copy()},
 					Loc:  p.Current.Loc.Copy(),
-				})
+				}
 				p.Next()
+
+				if p.Current.Match(TT_IDENT, "extends") {
+					p.Next()
+					constraint, err := p.ParseTypeDecl()
+					if err != nil {
+						return nil, err
+					}
+					typeVar.Constraint = constraint
+					typeVar.Loc = NewLocation(typeVar.Loc.File, typeVar.Loc.Start, constraint.Loc.End)
+				}
+
+				if p.Current.Match(TT_OP, "=") {
+					p.Next()
+					defaultType, err := p.ParseTypeDecl()
+					if err != nil {
+						return nil, err
+					}
+					typeVar.Default = defaultType
+					typeVar.Loc = NewLocation(typeVar.Loc.File, typeVar.Loc.Start, defaultType.Loc.End)
+				}
+
+				generics = append(generics, typeVar)
 			} else {
 				return nil, exception.NewSyntaxException("Expected type parameter name", p.Current.Loc)
 			}
@@ -196,6 +376,27 @@ func (p *Parser) ParseModelDecl() (*ModelDeclNode, exception.IException) {
 		model.Generics = generics
 	}
 
+	if p.Current.Match(TT_OP, "=") {
+		p.Next()
+		expr, err := p.ParseModelExpr()
+		if err != nil {
+			return nil, err
+		}
+
+		model.Derived = expr
+		model.Loc = NewLocation(start.File, start.Start, expr.Loc.End)
+		return &model, nil
+	}
+
+	if p.Current.Match(TT_IDENT, "extends") {
+		p.Next()
+		parent, err := p.ParseTypeDecl()
+		if err != nil {
+			return nil, err
+		}
+		model.Extends = parent
+	}
+
 	p.SkipNewLine()
 
 	if !p.Current.MatchType(TT_LBRACE) {
@@ -227,7 +428,23 @@ func (p *Parser) ParseModelDecl() (*ModelDeclNode, exception.IException) {
 			break
 		}
 
-		if p.Current.MatchType(TT_IDENT) {
+		if p.Current.MatchType(TT_SPREAD) {
+			if len(fieldAnnotations) > 0 {
+				return nil, exception.NewSyntaxException("Annotation must be followed by a field", fieldAnnotations[len(fieldAnnotations)-1].Loc)
+			}
+
+			spreadStart := p.Current.Loc
+			p.Next()
+			spreadType, err := p.ParseTypeDecl()
+			if err != nil {
+				return nil, err
+			}
+
+			model.Spreads = append(model.Spreads, &SpreadFieldsNode{
+				Type: spreadType,
+				Loc:  NewLocation(spreadStart.File, spreadStart.Start, spreadType.Loc.End),
+			})
+		} else if p.Current.MatchType(TT_IDENT) {
 			field, err := p.ParseModelFieldDecl()
 			if err != nil {
 				return nil, err
@@ -251,6 +468,63 @@ func (p *Parser) ParseModelDecl() (*ModelDeclNode, exception.IException) {
 	return &model, nil
 }
 
+func (p *Parser) ParseModelExpr() (*ModelExprNode, exception.IException) {
+	if p.Current == nil || !p.Current.MatchType(TT_IDENT) {
+		if p.Current == nil {
+			return nil, exception.NewSyntaxException("Expected model type", p.Tokens[len(p.Tokens)-1].Loc)
+		}
+		return nil, exception.NewSyntaxException("Expected model type", p.Current.Loc)
+	}
+
+	start := p.Current.Loc
+	next := p.Peek()
+	if !IsModelUtility(p.Current.Value) || next == nil || !next.Match(TT_OP, "<") {
+		ref, err := p.ParseTypeDecl()
+		if err != nil {
+			return nil, err
+		}
+
+		return &ModelExprNode{Ref: ref, Keys: make([]*StringValueNode, 0), Loc: ref.Loc.Copy()}, nil
+	}
+
+	node := &ModelExprNode{
+		Utility: &IdentNode{Value: p.Current.Value, Loc: p.Current.Loc.Copy()},
+		Keys:    make([]*StringValueNode, 0),
+	}
+	p.Next()
+	p.Next()
+
+	operand, err := p.ParseModelExpr()
+	if err != nil {
+		return nil, err
+	}
+	node.Operand = operand
+
+	for p.Current != nil && p.Current.MatchType(TT_COMMA) {
+		p.Next()
+		if p.Current == nil || !p.Current.MatchType(TT_STRING) {
+			if p.Current == nil {
+				return nil, exception.NewSyntaxException("Expected field name string", p.Tokens[len(p.Tokens)-1].Loc)
+			}
+			return nil, exception.NewSyntaxException("Expected field name string", p.Current.Loc)
+		}
+
+		node.Keys = append(node.Keys, &StringValueNode{Value: p.Current.Value, Loc: p.Current.Loc.Copy()})
+		p.Next()
+	}
+
+	if p.Current == nil || !p.Current.Match(TT_OP, ">") {
+		if p.Current == nil {
+			return nil, exception.NewSyntaxException("Expected ',' or '>' in model utility", p.Tokens[len(p.Tokens)-1].Loc)
+		}
+		return nil, exception.NewSyntaxException("Expected ',' or '>' in model utility", p.Current.Loc)
+	}
+
+	node.Loc = NewLocation(start.File, start.Start, p.Current.Loc.End)
+	p.Next()
+	return node, nil
+}
+
 func (p *Parser) ParseEnumDecl() (*EnumDeclNode, exception.IException) {
 	if p.Current == nil || !p.Current.Match(TT_IDENT, "enum") {
 		if p.Current == nil {
@@ -260,7 +534,7 @@ func (p *Parser) ParseEnumDecl() (*EnumDeclNode, exception.IException) {
 	}
 
 	start := p.Current.Loc
-	node := &EnumDeclNode{Members: make([]*IdentNode, 0)}
+	node := &EnumDeclNode{Members: make([]*EnumMemberNode, 0)}
 	p.Next()
 
 	if p.Current == nil || !p.Current.MatchType(TT_IDENT) {
@@ -290,12 +564,12 @@ func (p *Parser) ParseEnumDecl() (*EnumDeclNode, exception.IException) {
 			continue
 		}
 
-		if !p.Current.MatchType(TT_IDENT) {
-			return nil, exception.NewSyntaxException("Expected enum member name", p.Current.Loc)
+		member, err := p.ParseEnumMember()
+		if err != nil {
+			return nil, err
 		}
 
-		node.Members = append(node.Members, &IdentNode{Value: p.Current.Value, Loc: p.Current.Loc.Copy()})
-		p.Next()
+		node.Members = append(node.Members, member)
 
 		if p.Current != nil && p.Current.MatchType(TT_COMMA) {
 			p.Next()
@@ -317,6 +591,52 @@ func (p *Parser) ParseEnumDecl() (*EnumDeclNode, exception.IException) {
 	return node, nil
 }
 
+func (p *Parser) ParseEnumMember() (*EnumMemberNode, exception.IException) {
+	start := p.Current.Loc
+	member := &EnumMemberNode{
+		Annotations: make([]*AnnotationNode, 0),
+		Doc:         p.Current.Doc,
+	}
+
+	for p.Current != nil && p.Current.MatchType(TT_DECORATOR) {
+		annotation, err := p.ParseAnnotation()
+		if err != nil {
+			return nil, err
+		}
+		member.Annotations = append(member.Annotations, annotation)
+		p.SkipNewLine()
+	}
+
+	if p.Current == nil || !p.Current.MatchType(TT_IDENT) {
+		if p.Current == nil {
+			return nil, exception.NewSyntaxException("Expected enum member name", p.Tokens[len(p.Tokens)-1].Loc)
+		}
+		return nil, exception.NewSyntaxException("Expected enum member name", p.Current.Loc)
+	}
+
+	if member.Doc == "" {
+		member.Doc = p.Current.Doc
+	}
+
+	member.Name = &IdentNode{Value: p.Current.Value, Loc: p.Current.Loc.Copy()}
+	end := p.Current.Loc
+	p.Next()
+
+	if p.Current != nil && p.Current.Match(TT_OP, "=") {
+		p.Next()
+		value, err := p.ParseValue()
+		if err != nil {
+			return nil, err
+		}
+
+		member.Value = value
+		end = value.GetLocation()
+	}
+
+	member.Loc = NewLocation(start.File, start.Start, end.End)
+	return member, nil
+}
+
 func (p *Parser) ParseRestDecl() (*RestDeclNode, exception.IException) {
 	if p.Current == nil || !p.Current.Match(TT_IDENT, "rest") {
 		if p.Current == nil {
@@ -369,14 +689,17 @@ func (p *Parser) ParseRestDecl() (*RestDeclNode, exception.IException) {
 		seen[key] = true
 		p.Next()
 
-		if p.Current == nil || !p.Current.MatchType(TT_COLON) {
-			if p.Current == nil {
-				return nil, exception.NewSyntaxException("Expected ':' in rest property", p.Tokens[len(p.Tokens)-1].Loc)
+		isBlock := p.Current != nil && p.Current.MatchType(TT_LBRACE) && isRestBlockProperty(key)
+		if !isBlock {
+			if p.Current == nil || !p.Current.MatchType(TT_COLON) {
+				if p.Current == nil {
+					return nil, exception.NewSyntaxException("Expected ':' in rest property", p.Tokens[len(p.Tokens)-1].Loc)
+				}
+				return nil, exception.NewSyntaxException("Expected ':' in rest property", p.Current.Loc)
 			}
-			return nil, exception.NewSyntaxException("Expected ':' in rest property", p.Current.Loc)
-		}
 
-		p.Next()
+			p.Next()
+		}
 
 		switch key {
 		case "method":
@@ -404,11 +727,53 @@ func (p *Parser) ParseRestDecl() (*RestDeclNode, exception.IException) {
 			}
 			node.ResponseBodyType = t
 		case "queries":
+			if p.Current != nil && p.Current.MatchType(TT_LBRACE) {
+				block, err := p.ParseFieldBlock(false)
+				if err != nil {
+					return nil, err
+				}
+				node.Queries = block
+				break
+			}
+
 			v, err := p.ParseValue()
 			if err != nil {
 				return nil, err
 			}
 			node.QueriesValue = v
+		case "responses":
+			if p.Current != nil {
+				node.ResponsesLoc = p.Current.Loc.Copy()
+			}
+			responses, err := p.ParseRestResponses()
+			if err != nil {
+				return nil, err
+			}
+			node.Responses = responses
+		case "errors":
+			v, err := p.ParseValue()
+			if err != nil {
+				return nil, err
+			}
+			node.ErrorsValue = v
+		case "params":
+			block, err := p.ParseFieldBlock(false)
+			if err != nil {
+				return nil, err
+			}
+			node.Params = block
+		case "headers":
+			block, err := p.ParseFieldBlock(true)
+			if err != nil {
+				return nil, err
+			}
+			node.Headers = block
+		case "responseHeaders":
+			block, err := p.ParseFieldBlock(true)
+			if err != nil {
+				return nil, err
+			}
+			node.ResponseHeaders = block
 		default:
 			return nil, exception.NewSyntaxException("Unknown rest property '"+key+"'", p.Current.Loc)
 		}
@@ -422,9 +787,468 @@ func (p *Parser) ParseRestDecl() (*RestDeclNode, exception.IException) {
 
 	if p.Current == nil || !p.Current.MatchType(TT_RBRACE) {
 		if p.Current == nil {
-			return nil, exception.NewSyntaxException("Expected } at the end of rest declaration", p.Tokens[len(p.Tokens)-1].Loc)
+			return nil, exception.NewSyntaxException("Expected } at the end of rest declaration", p.Tokens[len(p.Tokens)-1].Loc)
+		}
+		return nil, exception.NewSyntaxException("Expected } at the end of rest declaration", p.Current.Loc)
+	}
+
+	node.Loc = NewLocation(start.File, start.Start, p.Current.Loc.End)
+	p.Next()
+
+	return node, nil
+}
+func (p *Parser) ParseStreamDecl() (*StreamDeclNode, exception.IException) {
+	node := &StreamDeclNode{Annotations: make([]*AnnotationNode, 0)}
+
+	name, loc, err := p.parseStreamingBody("stream", func(key string, keyLoc *Location) exception.IException {
+		switch key {
+		case "path":
+			v, err := p.ParseValue()
+			if err != nil {
+				return err
+			}
+			node.PathValue = v
+		case "params":
+			block, err := p.ParseFieldBlock(false)
+			if err != nil {
+				return err
+			}
+			node.Params = block
+		case "queries":
+			block, err := p.ParseFieldBlock(false)
+			if err != nil {
+				return err
+			}
+			node.Queries = block
+		case "event":
+			t, err := p.ParseTypeDecl()
+			if err != nil {
+				return err
+			}
+			node.EventType = t
+		default:
+			return exception.NewSyntaxException("Unknown stream property '"+key+"'", keyLoc)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	node.Name = name
+	node.Loc = loc
+	return node, nil
+}
+
+func (p *Parser) ParseChannelDecl() (*ChannelDeclNode, exception.IException) {
+	node := &ChannelDeclNode{
+		ClientMessages: make([]*TypeDeclNode, 0),
+		ServerMessages: make([]*TypeDeclNode, 0),
+		Annotations:    make([]*AnnotationNode, 0),
+	}
+
+	name, loc, err := p.parseStreamingBody("channel", func(key string, keyLoc *Location) exception.IException {
+		switch key {
+		case "path":
+			v, err := p.ParseValue()
+			if err != nil {
+				return err
+			}
+			node.PathValue = v
+		case "params":
+			block, err := p.ParseFieldBlock(false)
+			if err != nil {
+				return err
+			}
+			node.Params = block
+		case "queries":
+			block, err := p.ParseFieldBlock(false)
+			if err != nil {
+				return err
+			}
+			node.Queries = block
+		case "client":
+			types, err := p.ParseTypeList()
+			if err != nil {
+				return err
+			}
+			node.ClientMessages = types
+		case "server":
+			types, err := p.ParseTypeList()
+			if err != nil {
+				return err
+			}
+			node.ServerMessages = types
+		default:
+			return exception.NewSyntaxException("Unknown channel property '"+key+"'", keyLoc)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	node.Name = name
+	node.Loc = loc
+	return node, nil
+}
+
+func (p *Parser) parseStreamingBody(kind string, visit func(key string, keyLoc *Location) exception.IException) (*IdentNode, *Location, exception.IException) {
+	if p.Current == nil || !p.Current.Match(TT_IDENT, kind) {
+		if p.Current == nil {
+			return nil, nil, exception.NewSyntaxException("Expected '"+kind+"'", p.Tokens[len(p.Tokens)-1].Loc)
+		}
+		return nil, nil, exception.NewSyntaxException("Expected '"+kind+"'", p.Current.Loc)
+	}
+
+	start := p.Current.Loc
+	p.Next()
+
+	if p.Current == nil || !p.Current.MatchType(TT_IDENT) {
+		if p.Current == nil {
+			return nil, nil, exception.NewSyntaxException("Expected identifier for "+kind+" name", p.Tokens[len(p.Tokens)-1].Loc)
+		}
+		return nil, nil, exception.NewSyntaxException("Expected identifier for "+kind+" name", p.Current.Loc)
+	}
+
+	name := &IdentNode{Value: p.Current.Value, Loc: p.Current.Loc.Copy()}
+	p.Next()
+	p.SkipNewLine()
+
+	if p.Current == nil || !p.Current.MatchType(TT_LBRACE) {
+		if p.Current == nil {
+			return nil, nil, exception.NewSyntaxException("Expected { after "+kind+" name", p.Tokens[len(p.Tokens)-1].Loc)
+		}
+		return nil, nil, exception.NewSyntaxException("Expected { after "+kind+" name", p.Current.Loc)
+	}
+
+	p.Next()
+	p.SkipNewLine()
+
+	seen := map[string]bool{}
+	for p.Current != nil && !p.Current.MatchType(TT_RBRACE) && !p.Current.MatchType(TT_EOF) {
+		if p.Current.MatchType(TT_NEWLINE) {
+			p.SkipNewLine()
+			continue
+		}
+
+		if !p.Current.MatchType(TT_IDENT) {
+			return nil, nil, exception.NewSyntaxException("Expected property name in "+kind+" body", p.Current.Loc)
+		}
+
+		key := p.Current.Value
+		keyLoc := p.Current.Loc
+		if seen[key] {
+			return nil, nil, exception.NewSyntaxException("Duplicate "+kind+" property '"+key+"'", keyLoc)
+		}
+		seen[key] = true
+		p.Next()
+
+		isBlock := p.Current != nil && p.Current.MatchType(TT_LBRACE) && (key == "params" || key == "queries")
+		if !isBlock {
+			if p.Current == nil || !p.Current.MatchType(TT_COLON) {
+				if p.Current == nil {
+					return nil, nil, exception.NewSyntaxException("Expected ':' in "+kind+" property", p.Tokens[len(p.Tokens)-1].Loc)
+				}
+				return nil, nil, exception.NewSyntaxException("Expected ':' in "+kind+" property", p.Current.Loc)
+			}
+
+			p.Next()
+		}
+
+		if err := visit(key, keyLoc); err != nil {
+			return nil, nil, err
+		}
+
+		if p.Current != nil && p.Current.MatchType(TT_COMMA) {
+			p.Next()
+		}
+
+		p.SkipNewLine()
+	}
+
+	if p.Current == nil || !p.Current.MatchType(TT_RBRACE) {
+		if p.Current == nil {
+			return nil, nil, exception.NewSyntaxException("Expected } at the end of "+kind+" declaration", p.Tokens[len(p.Tokens)-1].Loc)
+		}
+		return nil, nil, exception.NewSyntaxException("Expected } at the end of "+kind+" declaration", p.Current.Loc)
+	}
+
+	loc := NewLocation(start.File, start.Start, p.Current.Loc.End)
+	p.Next()
+
+	return name, loc, nil
+}
+
+func (p *Parser) ParseTypeList() ([]*TypeDeclNode, exception.IException) {
+	if p.Current == nil || !p.Current.MatchType(TT_LSQUARE) {
+		if p.Current == nil {
+			return nil, exception.NewSyntaxException("Expected [", p.Tokens[len(p.Tokens)-1].Loc)
+		}
+		return nil, exception.NewSyntaxException("Expected [", p.Current.Loc)
+	}
+
+	types := make([]*TypeDeclNode, 0)
+	p.Next()
+	p.SkipNewLine()
+
+	for p.Current != nil && !p.Current.MatchType(TT_RSQUARE) && !p.Current.MatchType(TT_EOF) {
+		t, err := p.ParseTypeDecl()
+		if err != nil {
+			return nil, err
+		}
+		types = append(types, t)
+
+		p.SkipNewLine()
+		if p.Current != nil && p.Current.MatchType(TT_COMMA) {
+			p.Next()
+			p.SkipNewLine()
+		}
+	}
+
+	if p.Current == nil || !p.Current.MatchType(TT_RSQUARE) {
+		if p.Current == nil {
+			return nil, exception.NewSyntaxException("Expected ]", p.Tokens[len(p.Tokens)-1].Loc)
+		}
+		return nil, exception.NewSyntaxException("Expected ]", p.Current.Loc)
+	}
+	p.Next()
+
+	return types, nil
+}
+
+func (p *Parser) ParseRpcDecl() (*RpcDeclNode, exception.IException) {
+	if p.Current == nil || !p.Current.Match(TT_IDENT, "rpc") {
+		if p.Current == nil {
+			return nil, exception.NewSyntaxException("Expected 'rpc'", p.Tokens[len(p.Tokens)-1].Loc)
+		}
+		return nil, exception.NewSyntaxException("Expected 'rpc'", p.Current.Loc)
+	}
+
+	start := p.Current.Loc
+	node := &RpcDeclNode{
+		Throws:      make([]*IdentNode, 0),
+		Annotations: make([]*AnnotationNode, 0),
+	}
+	p.Next()
+
+	if p.Current == nil || !p.Current.MatchType(TT_IDENT) {
+		if p.Current == nil {
+			return nil, exception.NewSyntaxException("Expected identifier for rpc name", p.Tokens[len(p.Tokens)-1].Loc)
+		}
+		return nil, exception.NewSyntaxException("Expected identifier for rpc name", p.Current.Loc)
+	}
+
+	node.Name = &IdentNode{Value: p.Current.Value, Loc: p.Current.Loc.Copy()}
+	p.Next()
+
+	if p.Current == nil || !p.Current.MatchType(TT_LPAREN) {
+		if p.Current == nil {
+			return nil, exception.NewSyntaxException("Expected ( after rpc name", p.Tokens[len(p.Tokens)-1].Loc)
+		}
+		return nil, exception.NewSyntaxException("Expected ( after rpc name", p.Current.Loc)
+	}
+	p.Next()
+
+	if p.Current != nil && !p.Current.MatchType(TT_RPAREN) {
+		requestType, err := p.ParseTypeDecl()
+		if err != nil {
+			return nil, err
+		}
+		node.RequestType = requestType
+	}
+
+	if p.Current == nil || !p.Current.MatchType(TT_RPAREN) {
+		if p.Current == nil {
+			return nil, exception.NewSyntaxException("Expected ) after rpc request type", p.Tokens[len(p.Tokens)-1].Loc)
+		}
+		return nil, exception.NewSyntaxException("Expected ) after rpc request type", p.Current.Loc)
+	}
+	end := p.Current.Loc
+	p.Next()
+
+	if p.Current == nil || !p.Current.MatchType(TT_COLON) {
+		if p.Current == nil {
+			return nil, exception.NewSyntaxException("Expected ':' before rpc response type", p.Tokens[len(p.Tokens)-1].Loc)
+		}
+		return nil, exception.NewSyntaxException("Expected ':' before rpc response type", p.Current.Loc)
+	}
+	p.Next()
+
+	responseType, err := p.ParseTypeDecl()
+	if err != nil {
+		return nil, err
+	}
+	node.ResponseType = responseType
+	end = responseType.Loc
+
+	if p.Current != nil && p.Current.Match(TT_IDENT, "throws") {
+		p.Next()
+
+		for {
+			if p.Current == nil || !p.Current.MatchType(TT_IDENT) {
+				if p.Current == nil {
+					return nil, exception.NewSyntaxException("Expected error name after 'throws'", p.Tokens[len(p.Tokens)-1].Loc)
+				}
+				return nil, exception.NewSyntaxException("Expected error name after 'throws'", p.Current.Loc)
+			}
+
+			node.Throws = append(node.Throws, &IdentNode{Value: p.Current.Value, Loc: p.Current.Loc.Copy()})
+			end = p.Current.Loc
+			p.Next()
+
+			if p.Current == nil || !p.Current.MatchType(TT_COMMA) {
+				break
+			}
+			p.Next()
+		}
+	}
+
+	node.Loc = NewLocation(start.File, start.Start, end.End)
+	return node, nil
+}
+
+func (p *Parser) ParseServiceDecl() (*ServiceDeclNode, exception.IException) {
+	if p.Current == nil || !p.Current.Match(TT_IDENT, "service") {
+		if p.Current == nil {
+			return nil, exception.NewSyntaxException("Expected 'service'", p.Tokens[len(p.Tokens)-1].Loc)
+		}
+		return nil, exception.NewSyntaxException("Expected 'service'", p.Current.Loc)
+	}
+
+	start := p.Current.Loc
+	node := &ServiceDeclNode{
+		Rests:       make([]*RestDeclNode, 0),
+		Rpcs:        make([]*RpcDeclNode, 0),
+		Annotations: make([]*AnnotationNode, 0),
+	}
+	p.Next()
+
+	if p.Current == nil || !p.Current.MatchType(TT_IDENT) {
+		if p.Current == nil {
+			return nil, exception.NewSyntaxException("Expected identifier for service name", p.Tokens[len(p.Tokens)-1].Loc)
+		}
+		return nil, exception.NewSyntaxException("Expected identifier for service name", p.Current.Loc)
+	}
+
+	node.Name = &IdentNode{Value: p.Current.Value, Loc: p.Current.Loc.Copy()}
+	p.Next()
+	p.SkipNewLine()
+
+	if p.Current == nil || !p.Current.MatchType(TT_LBRACE) {
+		if p.Current == nil {
+			return nil, exception.NewSyntaxException("Expected { after service name", p.Tokens[len(p.Tokens)-1].Loc)
+		}
+		return nil, exception.NewSyntaxException("Expected { after service name", p.Current.Loc)
+	}
+
+	p.Next()
+	p.SkipNewLine()
+
+	seen := map[string]bool{}
+	for p.Current != nil && !p.Current.MatchType(TT_RBRACE) && !p.Current.MatchType(TT_EOF) {
+		if p.Current.MatchType(TT_NEWLINE) {
+			p.SkipNewLine()
+			continue
+		}
+
+		annotations := make([]*AnnotationNode, 0)
+		for p.Current != nil && p.Current.MatchType(TT_DECORATOR) {
+			annotation, err := p.ParseAnnotation()
+			if err != nil {
+				return nil, err
+			}
+			annotations = append(annotations, annotation)
+			p.SkipNewLine()
+		}
+
+		if p.Current == nil {
+			return nil, exception.NewSyntaxException("Expected } at the end of service declaration", p.Tokens[len(p.Tokens)-1].Loc)
+		}
+
+		if p.Current.Match(TT_IDENT, "rest") {
+			rest, err := p.ParseRestDecl()
+			if err != nil {
+				return nil, err
+			}
+
+			rest.Annotations = append(rest.Annotations, annotations...)
+			rest.Service = node
+			node.Rests = append(node.Rests, rest)
+			p.SkipNewLine()
+			continue
+		}
+
+		if p.Current.Match(TT_IDENT, "rpc") {
+			rpc, err := p.ParseRpcDecl()
+			if err != nil {
+				return nil, err
+			}
+
+			rpc.Annotations = append(rpc.Annotations, annotations...)
+			rpc.Service = node
+			node.Rpcs = append(node.Rpcs, rpc)
+			p.SkipNewLine()
+			continue
+		}
+
+		if len(annotations) > 0 {
+			return nil, exception.NewSyntaxException("Annotation in service must be followed by a rest or rpc declaration", annotations[len(annotations)-1].Loc)
+		}
+
+		if !p.Current.MatchType(TT_IDENT) {
+			return nil, exception.NewSyntaxException("Expected property name or rest declaration in service body", p.Current.Loc)
+		}
+
+		key := p.Current.Value
+		if seen[key] {
+			return nil, exception.NewSyntaxException("Duplicate service property '"+key+"'", p.Current.Loc)
+		}
+		seen[key] = true
+		keyLoc := p.Current.Loc
+		p.Next()
+
+		switch key {
+		case "basePath":
+			if p.Current == nil || !p.Current.MatchType(TT_COLON) {
+				if p.Current == nil {
+					return nil, exception.NewSyntaxException("Expected ':' in service property", p.Tokens[len(p.Tokens)-1].Loc)
+				}
+				return nil, exception.NewSyntaxException("Expected ':' in service property", p.Current.Loc)
+			}
+			p.Next()
+
+			v, err := p.ParseValue()
+			if err != nil {
+				return nil, err
+			}
+			node.BasePathValue = v
+		case "headers":
+			if p.Current != nil && p.Current.MatchType(TT_COLON) {
+				p.Next()
+			}
+
+			block, err := p.ParseFieldBlock(true)
+			if err != nil {
+				return nil, err
+			}
+			node.Headers = block
+		default:
+			return nil, exception.NewSyntaxException("Unknown service property '"+key+"'", keyLoc)
+		}
+
+		if p.Current != nil && p.Current.MatchType(TT_COMMA) {
+			p.Next()
+		}
+
+		p.SkipNewLine()
+	}
+
+	if p.Current == nil || !p.Current.MatchType(TT_RBRACE) {
+		if p.Current == nil {
+			return nil, exception.NewSyntaxException("Expected } at the end of service declaration", p.Tokens[len(p.Tokens)-1].Loc)
 		}
-		return nil, exception.NewSyntaxException("Expected } at the end of rest declaration", p.Current.Loc)
+		return nil, exception.NewSyntaxException("Expected } at the end of service declaration", p.Current.Loc)
 	}
 
 	node.Loc = NewLocation(start.File, start.Start, p.Current.Loc.End)
@@ -432,6 +1256,7 @@ func (p *Parser) ParseRestDecl() (*RestDeclNode, exception.IException) {
 
 	return node, nil
 }
+
 func (p *Parser) ParseEventDecl() (*EventDeclNode, exception.IException) {
 	if p.Current == nil || !p.Current.Match(TT_IDENT, "event") {
 		if p.Current == nil {
@@ -442,6 +1267,7 @@ func (p *Parser) ParseEventDecl() (*EventDeclNode, exception.IException) {
 
 	start := p.Current.Loc
 	node := &EventDeclNode{}
+	seen := make(map[string]bool)
 	p.Next()
 
 	if p.Current == nil || !p.Current.MatchType(TT_IDENT) {
@@ -453,6 +1279,12 @@ func (p *Parser) ParseEventDecl() (*EventDeclNode, exception.IException) {
 
 	node.Name = &IdentNode{Value: p.Current.Value, Loc: p.Current.Loc.Copy()}
 	p.Next()
+
+	if p.Current != nil && p.Current.MatchType(TT_IDENT) && IsEventVersionTag(p.Current.Value) {
+		node.VersionValue = &NumberValueNode{Value: p.Current.Value[1:], Loc: p.Current.Loc.Copy()}
+		p.Next()
+	}
+
 	p.SkipNewLine()
 
 	if p.Current == nil || !p.Current.MatchType(TT_LBRACE) {
@@ -482,16 +1314,24 @@ func (p *Parser) ParseEventDecl() (*EventDeclNode, exception.IException) {
 		}
 
 		key := p.Current.Value
+		if seen[key] {
+			return nil, exception.NewSyntaxException("Duplicate event property '"+key+"'", p.Current.Loc)
+		}
+		seen[key] = true
+		keyLoc := p.Current.Loc
 		p.Next()
 
-		if p.Current == nil || !p.Current.MatchType(TT_COLON) {
-			if p.Current == nil {
-				return nil, exception.NewSyntaxException("Expected ':' in event property", p.Tokens[len(p.Tokens)-1].Loc)
+		isBlock := key == "headers" && p.Current != nil && p.Current.MatchType(TT_LBRACE)
+		if !isBlock {
+			if p.Current == nil || !p.Current.MatchType(TT_COLON) {
+				if p.Current == nil {
+					return nil, exception.NewSyntaxException("Expected ':' in event property", p.Tokens[len(p.Tokens)-1].Loc)
+				}
+				return nil, exception.NewSyntaxException("Expected ':' in event property", p.Current.Loc)
 			}
-			return nil, exception.NewSyntaxException("Expected ':' in event property", p.Current.Loc)
-		}
 
-		p.Next()
+			p.Next()
+		}
 
 		switch key {
 		case "name":
@@ -506,6 +1346,44 @@ func (p *Parser) ParseEventDecl() (*EventDeclNode, exception.IException) {
 				return nil, err
 			}
 			node.PayloadType = t
+		case "topic", "channel":
+			if node.TopicValue != nil {
+				return nil, exception.NewSyntaxException("Event properties 'topic' and 'channel' cannot be used together", keyLoc)
+			}
+
+			v, err := p.ParseValue()
+			if err != nil {
+				return nil, err
+			}
+			node.TopicValue = v
+		case "key":
+			v, err := p.ParseValue()
+			if err != nil {
+				return nil, err
+			}
+			node.KeyValue = v
+		case "version":
+			if node.VersionValue != nil {
+				return nil, exception.NewSyntaxException("Event version is already declared after the event name", keyLoc)
+			}
+
+			v, err := p.ParseValue()
+			if err != nil {
+				return nil, err
+			}
+			node.VersionValue = v
+		case "dataschema":
+			v, err := p.ParseValue()
+			if err != nil {
+				return nil, err
+			}
+			node.DataSchemaValue = v
+		case "headers":
+			block, err := p.ParseFieldBlock(true)
+			if err != nil {
+				return nil, err
+			}
+			node.Headers = block
 		default:
 			return nil, exception.NewSyntaxException("Unknown event property '"+key+"'", p.Current.Loc)
 		}
@@ -640,6 +1518,182 @@ func (p *Parser) ParseErrorDecl() (*ErrorDeclNode, exception.IException) {
 	return node, nil
 }
 
+func (p *Parser) ParseConstDecl() (*ConstDeclNode, exception.IException) {
+	if p.Current == nil || !p.Current.Match(TT_IDENT, "const") {
+		if p.Current == nil {
+			return nil, exception.NewSyntaxException("Expected 'const'", p.Tokens[len(p.Tokens)-1].Loc)
+		}
+		return nil, exception.NewSyntaxException("Expected 'const'", p.Current.Loc)
+	}
+
+	start := p.Current.Loc
+	node := &ConstDeclNode{}
+	p.Next()
+
+	if p.Current == nil || !p.Current.MatchType(TT_IDENT) {
+		if p.Current == nil {
+			return nil, exception.NewSyntaxException("Expected identifier for constant name", p.Tokens[len(p.Tokens)-1].Loc)
+		}
+		return nil, exception.NewSyntaxException("Expected identifier for constant name", p.Current.Loc)
+	}
+
+	node.Name = &IdentNode{Value: p.Current.Value, Loc: p.Current.Loc.Copy()}
+	p.Next()
+
+	if p.Current == nil || !p.Current.MatchType(TT_COLON) {
+		if p.Current == nil {
+			return nil, exception.NewSyntaxException("Expected ':' after constant name", p.Tokens[len(p.Tokens)-1].Loc)
+		}
+		return nil, exception.NewSyntaxException("Expected ':' after constant name", p.Current.Loc)
+	}
+	p.Next()
+
+	typeNode, err := p.ParseTypeDecl()
+	if err != nil {
+		return nil, err
+	}
+	node.Type = typeNode
+
+	if p.Current == nil || !p.Current.Match(TT_OP, "=") {
+		if p.Current == nil {
+			return nil, exception.NewSyntaxException("Expected '=' in constant declaration", p.Tokens[len(p.Tokens)-1].Loc)
+		}
+		return nil, exception.NewSyntaxException("Expected '=' in constant declaration", p.Current.Loc)
+	}
+	p.Next()
+
+	value, err := p.ParseValue()
+	if err != nil {
+		return nil, err
+	}
+	node.Value = value
+
+	node.Loc = NewLocation(start.File, start.Start, value.GetLocation().End)
+	return node, nil
+}
+
+func (p *Parser) ParseAnnotationDecl() (*AnnotationDeclNode, exception.IException) {
+	if p.Current == nil || !(p.Current.Match(TT_IDENT, "annotation") || p.Current.Match(TT_IDENT, "validator")) {
+		if p.Current == nil {
+			return nil, exception.NewSyntaxException("Expected 'annotation' or 'validator'", p.Tokens[len(p.Tokens)-1].Loc)
+		}
+		return nil, exception.NewSyntaxException("Expected 'annotation' or 'validator'", p.Current.Loc)
+	}
+
+	start := p.Current.Loc
+	node := &AnnotationDeclNode{
+		Params:      make([]*AnnotationParamNode, 0),
+		Targets:     make([]*IdentNode, 0),
+		IsValidator: p.Current.Value == "validator",
+		ForTypes:    make([]*TypeDeclNode, 0),
+	}
+	p.Next()
+
+	if p.Current == nil || !p.Current.MatchType(TT_IDENT) {
+		if p.Current == nil {
+			return nil, exception.NewSyntaxException("Expected identifier for annotation name", p.Tokens[len(p.Tokens)-1].Loc)
+		}
+		return nil, exception.NewSyntaxException("Expected identifier for annotation name", p.Current.Loc)
+	}
+
+	node.Name = &IdentNode{Value: p.Current.Value, Loc: p.Current.Loc.Copy()}
+	end := p.Current.Loc.End
+	p.Next()
+
+	if p.Current != nil && p.Current.MatchType(TT_LPAREN) {
+		p.Next()
+		for p.Current != nil && !p.Current.MatchType(TT_RPAREN) {
+			if !p.Current.MatchType(TT_IDENT) {
+				return nil, exception.NewSyntaxException("Expected identifier for annotation parameter", p.Current.Loc)
+			}
+
+			param := &AnnotationParamNode{
+				Name: &IdentNode{Value: p.Current.Value, Loc: p.Current.Loc.Copy()},
+			}
+			paramStart := p.Current.Loc
+			p.Next()
+
+			if p.Current == nil || !p.Current.MatchType(TT_COLON) {
+				if p.Current == nil {
+					return nil, exception.NewSyntaxException("Expected ':' after annotation parameter name", p.Tokens[len(p.Tokens)-1].Loc)
+				}
+				return nil, exception.NewSyntaxException("Expected ':' after annotation parameter name", p.Current.Loc)
+			}
+			p.Next()
+
+			typeNode, err := p.ParseTypeDecl()
+			if err != nil {
+				return nil, err
+			}
+
+			param.Type = typeNode
+			param.Loc = NewLocation(paramStart.File, paramStart.Start, typeNode.Loc.End)
+			node.Params = append(node.Params, param)
+
+			if p.Current != nil && p.Current.MatchType(TT_COMMA) {
+				p.Next()
+				continue
+			}
+
+			if p.Current == nil || !p.Current.MatchType(TT_RPAREN) {
+				if p.Current == nil {
+					return nil, exception.NewSyntaxException("Expected ')' after annotation parameters", p.Tokens[len(p.Tokens)-1].Loc)
+				}
+				return nil, exception.NewSyntaxException("Expected ',' or ')' after annotation parameter", p.Current.Loc)
+			}
+		}
+
+		if p.Current == nil {
+			return nil, exception.NewSyntaxException("Expected ')' after annotation parameters", p.Tokens[len(p.Tokens)-1].Loc)
+		}
+
+		end = p.Current.Loc.End
+		p.Next()
+	}
+
+	if node.IsValidator && p.Current != nil && p.Current.Match(TT_IDENT, "for") {
+		p.Next()
+		for {
+			typeNode, err := p.ParseTypeDecl()
+			if err != nil {
+				return nil, err
+			}
+
+			node.ForTypes = append(node.ForTypes, typeNode)
+			end = typeNode.Loc.End
+
+			if p.Current == nil || !p.Current.MatchType(TT_COMMA) {
+				break
+			}
+			p.Next()
+		}
+	}
+
+	if !node.IsValidator && p.Current != nil && p.Current.Match(TT_IDENT, "on") {
+		p.Next()
+		for {
+			if p.Current == nil || !p.Current.MatchType(TT_IDENT) {
+				if p.Current == nil {
+					return nil, exception.NewSyntaxException("Expected annotation target", p.Tokens[len(p.Tokens)-1].Loc)
+				}
+				return nil, exception.NewSyntaxException("Expected annotation target", p.Current.Loc)
+			}
+
+			node.Targets = append(node.Targets, &IdentNode{Value: p.Current.Value, Loc: p.Current.Loc.Copy()})
+			end = p.Current.Loc.End
+			p.Next()
+
+			if p.Current == nil || !p.Current.MatchType(TT_COMMA) {
+				break
+			}
+			p.Next()
+		}
+	}
+
+	node.Loc = NewLocation(start.File, start.Start, end)
+	return node, nil
+}
+
 func (p *Parser) SkipNewLine() {
 	for p.Current != nil && p.Current.MatchType(TT_NEWLINE) {
 		p.Next()
@@ -677,7 +1731,20 @@ func (p *Parser) ParseValue() (ASTValueNode, exception.IException) {
 			return node, nil
 		}
 
-		return nil, exception.NewSyntaxException("Expected value literal", p.Current.Loc)
+		node := &ReferenceValueNode{
+			Name: &IdentNode{Value: p.Current.Value, Loc: p.Current.Loc.Copy()},
+			Loc:  p.Current.Loc.Copy(),
+		}
+		p.Next()
+		return node, nil
+
+	case p.Current.MatchType(TT_DECORATOR):
+		annotation, err := p.ParseAnnotation()
+		if err != nil {
+			return nil, err
+		}
+
+		return &AnnotationValueNode{Annotation: annotation, Loc: annotation.Loc.Copy()}, nil
 
 	case p.Current.MatchType(TT_LSQUARE):
 		p.Next()
@@ -825,6 +1892,106 @@ func (p *Parser) ParseAnnotation() (*AnnotationNode, exception.IException) {
 	return node, nil
 }
 
+func (p *Parser) ParseAnnotatedDecl(annotations []*AnnotationNode) (ASTNode, exception.IException) {
+	if p.Current == nil {
+		return nil, exception.NewSyntaxException("Annotation must be followed by a declaration", annotations[len(annotations)-1].Loc)
+	}
+
+	switch {
+	case p.Current.Match(TT_IDENT, "model"):
+		n, err := p.ParseModelDecl()
+		if err != nil {
+			return nil, err
+		}
+
+		n.Annotations = append(n.Annotations, annotations...)
+		return n, nil
+
+	case p.Current.Match(TT_IDENT, "enum"):
+		n, err := p.ParseEnumDecl()
+		if err != nil {
+			return nil, err
+		}
+
+		n.Annotations = append(n.Annotations, annotations...)
+		return n, nil
+
+	case p.Current.Match(TT_IDENT, "rest"):
+		n, err := p.ParseRestDecl()
+		if err != nil {
+			return nil, err
+		}
+
+		n.Annotations = append(n.Annotations, annotations...)
+		return n, nil
+
+	case p.Current.Match(TT_IDENT, "event"):
+		n, err := p.ParseEventDecl()
+		if err != nil {
+			return nil, err
+		}
+
+		n.Annotations = append(n.Annotations, annotations...)
+		return n, nil
+
+	case p.Current.Match(TT_IDENT, "service"):
+		n, err := p.ParseServiceDecl()
+		if err != nil {
+			return nil, err
+		}
+
+		n.Annotations = append(n.Annotations, annotations...)
+		return n, nil
+
+	case p.Current.Match(TT_IDENT, "rpc"):
+		n, err := p.ParseRpcDecl()
+		if err != nil {
+			return nil, err
+		}
+
+		n.Annotations = append(n.Annotations, annotations...)
+		return n, nil
+
+	case p.Current.Match(TT_IDENT, "stream"):
+		n, err := p.ParseStreamDecl()
+		if err != nil {
+			return nil, err
+		}
+
+		n.Annotations = append(n.Annotations, annotations...)
+		return n, nil
+
+	case p.Current.Match(TT_IDENT, "channel"):
+		n, err := p.ParseChannelDecl()
+		if err != nil {
+			return nil, err
+		}
+
+		n.Annotations = append(n.Annotations, annotations...)
+		return n, nil
+
+	case p.Current.Match(TT_IDENT, "error"):
+		n, err := p.ParseErrorDecl()
+		if err != nil {
+			return nil, err
+		}
+
+		n.Annotations = append(n.Annotations, annotations...)
+		return n, nil
+
+	case p.Current.Match(TT_IDENT, "const"):
+		n, err := p.ParseConstDecl()
+		if err != nil {
+			return nil, err
+		}
+
+		n.Annotations = append(n.Annotations, annotations...)
+		return n, nil
+	}
+
+	return nil, exception.NewSyntaxException("Annotation must be followed by a declaration", annotations[len(annotations)-1].Loc)
+}
+
 func (p *Parser) Parse() (*ProgramNode, exception.IException) {
 	program := &ProgramNode{
 		Body: make([]ASTNode, 0),
@@ -848,16 +2015,11 @@ func (p *Parser) Parse() (*ProgramNode, exception.IException) {
 				p.SkipNewLine()
 			}
 
-			if p.Current == nil || !p.Current.Match(TT_IDENT, "model") {
-				return nil, exception.NewSyntaxException("Annotation must be followed by a model", annotations[len(annotations)-1].Loc)
-			}
-
-			n, err := p.ParseModelDecl()
+			n, err := p.ParseAnnotatedDecl(annotations)
 			if err != nil {
 				return nil, err
 			}
 
-			n.Annotations = append(n.Annotations, annotations...)
 			program.Body = append(program.Body, n)
 
 		case p.Current.Match(TT_IDENT, "model"):
@@ -891,6 +2053,54 @@ func (p *Parser) Parse() (*ProgramNode, exception.IException) {
 
 			program.Body = append(program.Body, n)
 
+		case p.Current.Match(TT_IDENT, "service"):
+			n, err := p.ParseServiceDecl()
+			if err != nil {
+				return nil, err
+			}
+
+			program.Body = append(program.Body, n)
+
+		case p.Current.Match(TT_IDENT, "rpc"):
+			n, err := p.ParseRpcDecl()
+			if err != nil {
+				return nil, err
+			}
+
+			program.Body = append(program.Body, n)
+
+		case p.Current.Match(TT_IDENT, "stream"):
+			n, err := p.ParseStreamDecl()
+			if err != nil {
+				return nil, err
+			}
+
+			program.Body = append(program.Body, n)
+
+		case p.Current.Match(TT_IDENT, "channel"):
+			n, err := p.ParseChannelDecl()
+			if err != nil {
+				return nil, err
+			}
+
+			program.Body = append(program.Body, n)
+
+		case p.Current.Match(TT_IDENT, "const"):
+			n, err := p.ParseConstDecl()
+			if err != nil {
+				return nil, err
+			}
+
+			program.Body = append(program.Body, n)
+
+		case p.Current.Match(TT_IDENT, "annotation"), p.Current.Match(TT_IDENT, "validator"):
+			n, err := p.ParseAnnotationDecl()
+			if err != nil {
+				return nil, err
+			}
+
+			program.Body = append(program.Body, n)
+
 		case p.Current.Match(TT_IDENT, "error"):
 			n, err := p.ParseErrorDecl()
 			if err != nil {
@@ -0,0 +1,78 @@
+package parser
+
+import (
+	"testing"
+)
+
+func parseSource(t *testing.T, code string) *ProgramNode {
+	t.Helper()
+
+	tokens, err := NewLexer("test.contract").Start(code)
+	if err != nil {
+		t.Fatalf("unexpected lexer error: %s", err.GetMsg())
+	}
+
+	ast, err := NewParser("test.contract", tokens).Parse()
+	if err != nil {
+		t.Fatalf("unexpected parser error: %s", err.GetMsg())
+	}
+	return ast
+}
+
+func TestParseInlineQueryBlock(t *testing.T) {
+	ast := parseSource(t, `
+rest ListOrders {
+  method: "GET"
+  path: "/orders"
+  queries { page?: Int = 1, q?: String @MaxLength(100, "too long") , status?: Array<String> }
+}
+`)
+
+	rest, ok := ast.Body[0].(*RestDeclNode)
+	if !ok || rest.Queries == nil {
+		t.Fatalf("expected a rest with a query block, got %#v", ast.Body[0])
+	}
+
+	fields := rest.Queries.Fields
+	if len(fields) != 3 {
+		t.Fatalf("expected 3 query fields, got %d", len(fields))
+	}
+
+	if fields[0].Name.Value != "page" || !fields[0].Optional || fields[0].DefaultValue == nil {
+		t.Errorf("unexpected page field: %#v", fields[0])
+	}
+
+	if len(fields[1].Annotations) != 1 || fields[1].Annotations[0].Name.Value != "MaxLength" {
+		t.Errorf("expected q to carry a trailing MaxLength annotation, got %#v", fields[1].Annotations)
+	}
+
+	if fields[2].Type.Name.Value != "Array" {
+		t.Errorf("expected status to be an Array, got %s", FormatTypeDecl(fields[2].Type))
+	}
+}
+
+func TestParseFieldBlockErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		block string
+		want  string
+	}{
+		{"dangling annotation", `queries { @MaxLength(1, "x") }`, "Annotation must be followed by a field"},
+		{"missing type", `queries { page? }`, "Excepted field type"},
+		{"missing name", `queries { : Int }`, "Expected field name"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokens, err := NewLexer("test.contract").Start("rest ListOrders {\n  " + tt.block + "\n}\n")
+			if err != nil {
+				t.Fatalf("unexpected lexer error: %s", err.GetMsg())
+			}
+
+			_, err = NewParser("test.contract", tokens).Parse()
+			if err == nil || err.GetMsg() != tt.want {
+				t.Fatalf("expected %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
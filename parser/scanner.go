@@ -37,6 +37,14 @@ func (s *Scanner) Peek() rune {
 	return rune(s.Code[s.NextIdx])
 }
 
+func (s *Scanner) PeekAt(offset int) rune {
+	idx := s.NextIdx + offset
+	if idx >= len(s.Code) {
+		return nullRune
+	}
+	return rune(s.Code[idx])
+}
+
 func (s *Scanner) GetPosition() *Position {
 	return &Position{
 		Col:  s.Col,
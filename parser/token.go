@@ -29,11 +29,14 @@ var (
 	TT_QUES      TokenType = ins()
 	TT_NEWLINE   TokenType = ins()
 	TT_OP        TokenType = ins()
+	TT_DOC       TokenType = ins()
+	TT_SPREAD    TokenType = ins()
 )
 
 type Token struct {
 	Type  TokenType
 	Value string
+	Doc   string
 	Loc   *Location
 }
 
@@ -2,6 +2,9 @@ package parser
 
 import (
 	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -20,6 +23,7 @@ type TypeSymbol struct {
 	IsBuiltIn bool
 	Generics  []*TypeVarNode
 	DeclKind  string
+	Decl      ASTNode
 }
 
 const (
@@ -33,7 +37,8 @@ type RestSymbol struct {
 	Name string
 }
 type EventSymbol struct {
-	Name string
+	Name     string
+	Versions []*EventDeclNode
 }
 
 func (s *EventSymbol) GetName() string {
@@ -50,6 +55,7 @@ func (s *EventSymbol) GetKind() string {
 
 type ErrorSymbol struct {
 	Name string
+	Decl *ErrorDeclNode
 }
 
 func (s *TypeSymbol) GetName() string {
@@ -138,16 +144,188 @@ func (s *ErrorSymbol) BuiltIn() bool {
 	return false
 }
 
-func NewErrorSymbol(name string) *ErrorSymbol {
-	return &ErrorSymbol{Name: name}
+func NewErrorSymbol(name string, decl *ErrorDeclNode) *ErrorSymbol {
+	return &ErrorSymbol{Name: name, Decl: decl}
+}
+
+type StreamSymbol struct {
+	Name string
+}
+
+func (s *StreamSymbol) GetName() string {
+	return s.Name
+}
+
+func (s *StreamSymbol) GetGenerics() []*TypeVarNode {
+	return nil
+}
+
+func (s *StreamSymbol) GetKind() string {
+	return "stream"
+}
+
+func (s *StreamSymbol) BuiltIn() bool {
+	return false
+}
+
+func NewStreamSymbol(name string) *StreamSymbol {
+	return &StreamSymbol{Name: name}
+}
+
+type ChannelSymbol struct {
+	Name string
+}
+
+func (s *ChannelSymbol) GetName() string {
+	return s.Name
+}
+
+func (s *ChannelSymbol) GetGenerics() []*TypeVarNode {
+	return nil
+}
+
+func (s *ChannelSymbol) GetKind() string {
+	return "channel"
+}
+
+func (s *ChannelSymbol) BuiltIn() bool {
+	return false
+}
+
+func NewChannelSymbol(name string) *ChannelSymbol {
+	return &ChannelSymbol{Name: name}
+}
+
+type RpcSymbol struct {
+	Name string
+	Decl *RpcDeclNode
+}
+
+func (s *RpcSymbol) GetName() string {
+	return s.Name
+}
+
+func (s *RpcSymbol) GetGenerics() []*TypeVarNode {
+	return nil
+}
+
+func (s *RpcSymbol) GetKind() string {
+	return "rpc"
+}
+
+func (s *RpcSymbol) BuiltIn() bool {
+	return false
+}
+
+func NewRpcSymbol(name string, decl *RpcDeclNode) *RpcSymbol {
+	return &RpcSymbol{Name: name, Decl: decl}
+}
+
+type ServiceSymbol struct {
+	Name string
+	Decl *ServiceDeclNode
+}
+
+func (s *ServiceSymbol) GetName() string {
+	return s.Name
+}
+
+func (s *ServiceSymbol) GetGenerics() []*TypeVarNode {
+	return nil
+}
+
+func (s *ServiceSymbol) GetKind() string {
+	return "service"
+}
+
+func (s *ServiceSymbol) BuiltIn() bool {
+	return false
+}
+
+func NewServiceSymbol(name string, decl *ServiceDeclNode) *ServiceSymbol {
+	return &ServiceSymbol{Name: name, Decl: decl}
+}
+
+type ConstSymbol struct {
+	Name string
+	Decl *ConstDeclNode
+}
+
+func (s *ConstSymbol) GetName() string {
+	return s.Name
+}
+
+func (s *ConstSymbol) GetGenerics() []*TypeVarNode {
+	return nil
+}
+
+func (s *ConstSymbol) GetKind() string {
+	return "const"
+}
+
+func (s *ConstSymbol) BuiltIn() bool {
+	return false
+}
+
+func NewConstSymbol(name string, decl *ConstDeclNode) *ConstSymbol {
+	return &ConstSymbol{Name: name, Decl: decl}
 }
 
 type AnnotationSymbol struct {
-	Name      string
-	IsBuiltIn bool
-	Generics  []*TypeVarNode
-	Args      map[string]*TypeDeclNode
-	ArgOrder  []string
+	Name        string
+	IsBuiltIn   bool
+	Generics    []*TypeVarNode
+	Args        map[string]*TypeDeclNode
+	ArgOrder    []string
+	Targets     []string
+	IsValidator bool
+	FieldTypes  []*TypeDeclNode
+	Optional    map[string]bool
+}
+
+const (
+	AnnotationTargetModel      = "model"
+	AnnotationTargetField      = "field"
+	AnnotationTargetEnum       = "enum"
+	AnnotationTargetEnumMember = "enum member"
+	AnnotationTargetRest       = "rest"
+	AnnotationTargetEvent      = "event"
+	AnnotationTargetError      = "error"
+	AnnotationTargetConst      = "const"
+	AnnotationTargetService    = "service"
+	AnnotationTargetRpc        = "rpc"
+	AnnotationTargetStream     = "stream"
+	AnnotationTargetChannel    = "channel"
+)
+
+var annotationTargetKeywords = map[string]string{
+	"model":   AnnotationTargetModel,
+	"field":   AnnotationTargetField,
+	"enum":    AnnotationTargetEnum,
+	"member":  AnnotationTargetEnumMember,
+	"rest":    AnnotationTargetRest,
+	"event":   AnnotationTargetEvent,
+	"error":   AnnotationTargetError,
+	"const":   AnnotationTargetConst,
+	"service": AnnotationTargetService,
+	"rpc":     AnnotationTargetRpc,
+	"stream":  AnnotationTargetStream,
+	"channel": AnnotationTargetChannel,
+}
+
+var allAnnotationTargets = []string{
+	AnnotationTargetModel,
+	AnnotationTargetField,
+	AnnotationTargetEnum,
+	AnnotationTargetEnumMember,
+	AnnotationTargetRest,
+	AnnotationTargetEvent,
+	AnnotationTargetError,
+	AnnotationTargetConst,
+	AnnotationTargetService,
+	AnnotationTargetRpc,
+	AnnotationTargetStream,
+	AnnotationTargetChannel,
 }
 
 func (s *AnnotationSymbol) GetName() string {
@@ -172,6 +350,20 @@ func (s *AnnotationSymbol) BuiltIn() bool {
 	return s.IsBuiltIn
 }
 
+func (s *AnnotationSymbol) AppliesTo(target string) bool {
+	if s == nil {
+		return false
+	}
+
+	for _, item := range s.Targets {
+		if item == target {
+			return true
+		}
+	}
+
+	return false
+}
+
 func NewAnnotationSymbol(name string, isBuiltIn bool) *AnnotationSymbol {
 	return &AnnotationSymbol{
 		Name:      name,
@@ -179,6 +371,8 @@ func NewAnnotationSymbol(name string, isBuiltIn bool) *AnnotationSymbol {
 		Generics:  make([]*TypeVarNode, 0),
 		Args:      make(map[string]*TypeDeclNode),
 		ArgOrder:  make([]string, 0),
+		Targets:   make([]string, 0),
+		Optional:  make(map[string]bool),
 	}
 }
 
@@ -248,6 +442,48 @@ func (c *Context) GetAnnotationByName(name string) *AnnotationSymbol {
 	return a
 }
 
+func (c *Context) GetErrorByName(name string) *ErrorSymbol {
+	sym := c.GetByName(name)
+	if sym == nil {
+		return nil
+	}
+
+	e, ok := (*sym).(*ErrorSymbol)
+	if !ok {
+		return nil
+	}
+
+	return e
+}
+
+func (c *Context) GetEventByName(name string) *EventSymbol {
+	sym := c.GetByName(name)
+	if sym == nil {
+		return nil
+	}
+
+	e, ok := (*sym).(*EventSymbol)
+	if !ok {
+		return nil
+	}
+
+	return e
+}
+
+func (c *Context) GetConstByName(name string) *ConstSymbol {
+	sym := c.GetByName(name)
+	if sym == nil {
+		return nil
+	}
+
+	k, ok := (*sym).(*ConstSymbol)
+	if !ok {
+		return nil
+	}
+
+	return k
+}
+
 func NewContext(parent *Context) *Context {
 	return &Context{
 		Parent:  parent,
@@ -291,6 +527,7 @@ func (c *TypeChecker) FindAllSymbol(prog *ProgramNode) exception.IException {
 
 			sym := NewModelTypeSymbol(v.Name.Value)
 			sym.Generics = v.Generics
+			sym.Decl = v
 			if c.Context.Find(sym) {
 				return exception.NewTypeException(fmt.Sprintf("Name '%s' is already defined", sym.Name), v.Name.Loc)
 			}
@@ -302,6 +539,7 @@ func (c *TypeChecker) FindAllSymbol(prog *ProgramNode) exception.IException {
 			}
 
 			sym := NewEnumTypeSymbol(v.Name.Value)
+			sym.Decl = v
 			if c.Context.Find(sym) {
 				return exception.NewTypeException(fmt.Sprintf("Name '%s' is already defined", sym.Name), v.Name.Loc)
 			}
@@ -317,24 +555,125 @@ func (c *TypeChecker) FindAllSymbol(prog *ProgramNode) exception.IException {
 				return exception.NewTypeException(fmt.Sprintf("Name '%s' is already defined", sym.Name), v.Name.Loc)
 			}
 
+			c.Context.Add(sym)
+		case *ServiceDeclNode:
+			if v.Name == nil {
+				return exception.NewTypeException("Service name is missing", v.Loc)
+			}
+
+			sym := NewServiceSymbol(v.Name.Value, v)
+			if c.Context.Find(sym) {
+				return exception.NewTypeException(fmt.Sprintf("Name '%s' is already defined", sym.Name), v.Name.Loc)
+			}
+
+			c.Context.Add(sym)
+
+			for _, rest := range v.Rests {
+				if rest.Name == nil {
+					return exception.NewTypeException("Rest name is missing", rest.Loc)
+				}
+
+				restSym := NewRestSymbol(rest.Name.Value)
+				if c.Context.Find(restSym) {
+					return exception.NewTypeException(fmt.Sprintf("Name '%s' is already defined", restSym.Name), rest.Name.Loc)
+				}
+
+				c.Context.Add(restSym)
+			}
+
+			for _, rpc := range v.Rpcs {
+				if err := c.registerRpc(rpc); err != nil {
+					return err
+				}
+			}
+		case *RpcDeclNode:
+			if err := c.registerRpc(v); err != nil {
+				return err
+			}
+		case *StreamDeclNode:
+			if v.Name == nil {
+				return exception.NewTypeException("Stream name is missing", v.Loc)
+			}
+
+			sym := NewStreamSymbol(v.Name.Value)
+			if c.Context.Find(sym) {
+				return exception.NewTypeException(fmt.Sprintf("Name '%s' is already defined", sym.Name), v.Name.Loc)
+			}
+
+			c.Context.Add(sym)
+		case *ChannelDeclNode:
+			if v.Name == nil {
+				return exception.NewTypeException("Channel name is missing", v.Loc)
+			}
+
+			sym := NewChannelSymbol(v.Name.Value)
+			if c.Context.Find(sym) {
+				return exception.NewTypeException(fmt.Sprintf("Name '%s' is already defined", sym.Name), v.Name.Loc)
+			}
+
 			c.Context.Add(sym)
 		case *EventDeclNode:
 			if v.Name == nil {
 				return exception.NewTypeException("Event name is missing", v.Loc)
 			}
 
+			if existing := c.Context.GetEventByName(v.Name.Value); existing != nil {
+				existing.Versions = append(existing.Versions, v)
+				continue
+			}
+
 			sym := NewEventSymbol(v.Name.Value)
 			if c.Context.Find(sym) {
 				return exception.NewTypeException(fmt.Sprintf("Name '%s' is already defined", sym.Name), v.Name.Loc)
 			}
 
+			sym.Versions = append(sym.Versions, v)
 			c.Context.Add(sym)
 		case *ErrorDeclNode:
 			if v.Name == nil {
 				return exception.NewTypeException("Error name is missing", v.Loc)
 			}
 
-			sym := NewErrorSymbol(v.Name.Value)
+			sym := NewErrorSymbol(v.Name.Value, v)
+			if c.Context.Find(sym) {
+				return exception.NewTypeException(fmt.Sprintf("Name '%s' is already defined", sym.Name), v.Name.Loc)
+			}
+
+			c.Context.Add(sym)
+		case *AnnotationDeclNode:
+			if v.Name == nil {
+				return exception.NewTypeException("Annotation name is missing", v.Loc)
+			}
+
+			sym := NewAnnotationSymbol(v.Name.Value, false)
+			for _, param := range v.Params {
+				sym.ArgOrder = append(sym.ArgOrder, param.Name.Value)
+				sym.Args[param.Name.Value] = param.Type
+			}
+			for _, target := range v.Targets {
+				if kind, ok := annotationTargetKeywords[target.Value]; ok {
+					sym.Targets = append(sym.Targets, kind)
+				}
+			}
+			if v.IsValidator {
+				sym.IsValidator = true
+				sym.Targets = append(sym.Targets, AnnotationTargetField)
+				sym.FieldTypes = append(sym.FieldTypes, v.ForTypes...)
+			} else if len(v.Targets) == 0 {
+				sym.Targets = append(sym.Targets, allAnnotationTargets...)
+			}
+
+			if c.Context.Find(sym) {
+				return exception.NewTypeException(fmt.Sprintf("Name '%s' is already defined", sym.Name), v.Name.Loc)
+			}
+
+			c.Context.Add(sym)
+		case *ConstDeclNode:
+			if v.Name == nil {
+				return exception.NewTypeException("Constant name is missing", v.Loc)
+			}
+
+			sym := NewConstSymbol(v.Name.Value, v)
 			if c.Context.Find(sym) {
 				return exception.NewTypeException(fmt.Sprintf("Name '%s' is already defined", sym.Name), v.Name.Loc)
 			}
@@ -353,59 +692,77 @@ func (c *TypeChecker) CheckType(node *TypeDeclNode) exception.IException {
 		return exception.NewTypeException(fmt.Sprintf("Type '%s' is not defined", node.Name.Value), node.Name.Loc)
 	}
 
-	if len(sym.Generics) != len(node.Generics) {
+	required := requiredTypeParams(sym.Generics)
+	if len(node.Generics) < required || len(node.Generics) > len(sym.Generics) {
+		expected := fmt.Sprintf("%d", len(sym.Generics))
+		if required != len(sym.Generics) {
+			expected = fmt.Sprintf("%d to %d", required, len(sym.Generics))
+		}
+
 		return exception.NewTypeException(
-			fmt.Sprintf("Type '%s' expects %d generic argument(s), got %d", node.Name.Value, len(sym.Generics), len(node.Generics)),
+			fmt.Sprintf("Type '%s' expects %s generic argument(s), got %d", node.Name.Value, expected, len(node.Generics)),
 			node.Loc,
 		)
 	}
 
-	return nil
-}
-
-func (c *TypeChecker) CheckAnnotations(nodes []*AnnotationNode) exception.IException {
-	for _, node := range nodes {
-		err := c.CheckAnnotation(node)
-		if err != nil {
+	bindings := make(map[string]*TypeDeclNode, len(node.Generics))
+	for i, arg := range node.Generics {
+		if err := c.CheckType(arg); err != nil {
 			return err
 		}
-	}
 
-	return nil
-}
+		param := sym.Generics[i]
+		if param == nil || param.Name == nil {
+			continue
+		}
+		bindings[param.Name.Value] = arg
 
-func (c *TypeChecker) CheckAnnotation(node *AnnotationNode) exception.IException {
-	if node == nil || node.Name == nil {
-		var loc *Location
-		if node != nil {
-			loc = node.Loc
+		constraint := param.Constraint
+		if constraint != nil && constraint.Name != nil {
+			if bound, ok := bindings[constraint.Name.Value]; ok {
+				constraint = bound
+			}
 		}
-		return exception.NewTypeException("Annotation name is missing", loc)
-	}
 
-	sym := c.Context.GetAnnotationByName(node.Name.Value)
-	if sym == nil {
-		return exception.NewTypeException(fmt.Sprintf("Annotation '%s' is not defined", node.Name.Value), node.Name.Loc)
+		if !c.satisfiesConstraint(arg, constraint) {
+			return exception.NewTypeException(
+				fmt.Sprintf("Type '%s' does not satisfy constraint '%s' of type parameter '%s'", FormatTypeDecl(arg), FormatTypeDecl(constraint), param.Name.Value),
+				arg.Loc,
+			)
+		}
 	}
 
-	if len(node.Args) != len(sym.ArgOrder) {
-		return exception.NewTypeException(
-			fmt.Sprintf("Annotation '%s' expects %d argument(s), got %d", sym.Name, len(sym.ArgOrder), len(node.Args)),
-			node.Loc,
-		)
-	}
+	return nil
+}
 
-	for i, arg := range node.Args {
-		argName := sym.ArgOrder[i]
-		expected := sym.Args[argName]
-		if expected == nil || expected.Name == nil {
+func (c *TypeChecker) CheckTypeParams(params []*TypeVarNode) exception.IException {
+	seenDefault := false
+	for _, typeVar := range params {
+		if typeVar.Constraint != nil {
+			if err := c.CheckType(typeVar.Constraint); err != nil {
+				return err
+			}
+		}
+
+		if typeVar.Default == nil {
+			if seenDefault {
+				return exception.NewTypeException(
+					fmt.Sprintf("Type parameter '%s' must have a default because a previous type parameter has one", typeVar.Name.Value),
+					typeVar.Loc,
+				)
+			}
 			continue
 		}
 
-		if !isValueAssignableToType(arg, expected.Name.Value) {
+		seenDefault = true
+		if err := c.CheckType(typeVar.Default); err != nil {
+			return err
+		}
+
+		if !c.satisfiesConstraint(typeVar.Default, typeVar.Constraint) {
 			return exception.NewTypeException(
-				fmt.Sprintf("Annotation '%s' argument '%s' expects type '%s'", sym.Name, argName, expected.Name.Value),
-				arg.GetLocation(),
+				fmt.Sprintf("Default type '%s' does not satisfy constraint '%s' of type parameter '%s'", FormatTypeDecl(typeVar.Default), FormatTypeDecl(typeVar.Constraint), typeVar.Name.Value),
+				typeVar.Default.Loc,
 			)
 		}
 	}
@@ -413,239 +770,1831 @@ func (c *TypeChecker) CheckAnnotation(node *AnnotationNode) exception.IException
 	return nil
 }
 
-func (c *TypeChecker) CheckModelFieldType(node *ModelFieldDeclNode) exception.IException {
-	err := c.CheckType(node.Type)
-	if err != nil {
-		return err
-	}
+func (c *TypeChecker) satisfiesConstraint(arg *TypeDeclNode, constraint *TypeDeclNode) bool {
+	return c.satisfiesConstraintFrom(arg, constraint, map[string]bool{})
+}
 
-	err = c.CheckAnnotations(node.Annotations)
-	if err != nil {
-		return err
+func (c *TypeChecker) satisfiesConstraintFrom(arg *TypeDeclNode, constraint *TypeDeclNode, visited map[string]bool) bool {
+	if constraint == nil || constraint.Name == nil || constraint.Name.Value == "Any" {
+		return true
 	}
 
-	if hasAnnotationNode(node.Annotations, "NestedValidate") && !c.isModelTypeOrArrayOfModel(node.Type) {
-		return exception.NewTypeException("Annotation 'NestedValidate' can only be used on model or Array<Model> fields", node.Type.Loc)
+	if arg == nil || arg.Name == nil {
+		return false
 	}
 
-	return nil
-}
-
-func (c *TypeChecker) CheckModelType(node *ModelDeclNode) exception.IException {
-	if node.Name == nil {
-		return exception.NewTypeException("Model name is missing", node.Loc)
+	if arg.Name.Value == constraint.Name.Value {
+		return c.typeArgsMatch(arg, constraint)
 	}
 
-	ctx_ := c.Context
-	c.Context = NewContext(c.Context)
+	sym := c.Context.GetTypeByName(arg.Name.Value)
+	if sym == nil {
+		return false
+	}
 
-	for _, typeVar := range node.Generics {
+	switch sym.DeclKind {
+	case TypeDeclKindGeneric:
+		typeVar, ok := sym.Decl.(*TypeVarNode)
+		if !ok || typeVar.Constraint == nil {
+			return false
+		}
+		return c.satisfiesConstraintFrom(typeVar.Constraint, constraint, visited)
+	case TypeDeclKindModel:
+		decl := c.modelDecl(arg.Name.Value)
+		if decl == nil || visited[decl.Name.Value] {
+			return false
+		}
+		visited[decl.Name.Value] = true
+
+		bindings := make(map[string]*TypeDeclNode, len(decl.Generics))
+		args := c.typeArgsWithDefaults(arg)
+		for i, param := range decl.Generics {
+			if param != nil && param.Name != nil && i < len(args) {
+				bindings[param.Name.Value] = args[i]
+			}
+		}
+
+		parents := make([]*TypeDeclNode, 0, len(decl.Spreads)+1)
+		if decl.Extends != nil {
+			parents = append(parents, decl.Extends)
+		}
+		for _, spread := range decl.Spreads {
+			if spread != nil {
+				parents = append(parents, spread.Type)
+			}
+		}
+
+		for _, parent := range parents {
+			if c.satisfiesConstraintFrom(substituteTypeDecl(parent, bindings), constraint, visited) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (c *TypeChecker) typeArgsMatch(arg *TypeDeclNode, constraint *TypeDeclNode) bool {
+	if len(constraint.Generics) == 0 {
+		return true
+	}
+
+	args := c.typeArgsWithDefaults(arg)
+	if len(args) != len(constraint.Generics) {
+		return false
+	}
+
+	for i, item := range args {
+		if !sameTypeDecl(item, constraint.Generics[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (c *TypeChecker) typeArgsWithDefaults(node *TypeDeclNode) []*TypeDeclNode {
+	decl := c.modelDecl(node.Name.Value)
+	if decl == nil || len(node.Generics) >= len(decl.Generics) {
+		return node.Generics
+	}
+
+	args := append([]*TypeDeclNode{}, node.Generics...)
+	for _, param := range decl.Generics[len(node.Generics):] {
+		if param == nil || param.Default == nil {
+			break
+		}
+		args = append(args, param.Default)
+	}
+
+	return args
+}
+
+func sameTypeDecl(a *TypeDeclNode, b *TypeDeclNode) bool {
+	if a == nil || b == nil || a.Name == nil || b.Name == nil {
+		return a == b
+	}
+
+	if b.Name.Value == "Any" {
+		return true
+	}
+
+	if a.Name.Value != b.Name.Value || len(a.Generics) != len(b.Generics) {
+		return false
+	}
+
+	for i, item := range a.Generics {
+		if !sameTypeDecl(item, b.Generics[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func substituteTypeDecl(node *TypeDeclNode, bindings map[string]*TypeDeclNode) *TypeDeclNode {
+	if node == nil || node.Name == nil || len(bindings) == 0 {
+		return node
+	}
+
+	if bound, ok := bindings[node.Name.Value]; ok && len(node.Generics) == 0 {
+		return bound
+	}
+
+	copied := *node
+	copied.Generics = make([]*TypeDeclNode, 0, len(node.Generics))
+	for _, item := range node.Generics {
+		copied.Generics = append(copied.Generics, substituteTypeDecl(item, bindings))
+	}
+
+	return &copied
+}
+
+func requiredTypeParams(params []*TypeVarNode) int {
+	required := 0
+	for _, param := range params {
+		if param != nil && param.Default == nil {
+			required++
+		}
+	}
+
+	return required
+}
+
+func (c *TypeChecker) CheckAnnotations(nodes []*AnnotationNode, target string) exception.IException {
+	for _, node := range nodes {
+		err := c.CheckAnnotation(node, target)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *TypeChecker) CheckAnnotation(node *AnnotationNode, target string) exception.IException {
+	if node == nil || node.Name == nil {
+		var loc *Location
+		if node != nil {
+			loc = node.Loc
+		}
+		return exception.NewTypeException("Annotation name is missing", loc)
+	}
+
+	sym := c.Context.GetAnnotationByName(node.Name.Value)
+	if sym == nil {
+		return exception.NewTypeException(fmt.Sprintf("Annotation '%s' is not defined", node.Name.Value), node.Name.Loc)
+	}
+
+	if !sym.AppliesTo(target) {
+		return exception.NewTypeException(
+			fmt.Sprintf("Annotation '%s' cannot be used on %s declarations", sym.Name, target),
+			node.Loc,
+		)
+	}
+
+	required := 0
+	for _, argName := range sym.ArgOrder {
+		if !sym.Optional[argName] {
+			required++
+		}
+	}
+
+	if len(node.Args) < required || len(node.Args) > len(sym.ArgOrder) {
+		expected := fmt.Sprintf("%d", len(sym.ArgOrder))
+		if required != len(sym.ArgOrder) {
+			expected = fmt.Sprintf("%d to %d", required, len(sym.ArgOrder))
+		}
+
+		return exception.NewTypeException(
+			fmt.Sprintf("Annotation '%s' expects %s argument(s), got %d", sym.Name, expected, len(node.Args)),
+			node.Loc,
+		)
+	}
+
+	for i, arg := range node.Args {
+		argName := sym.ArgOrder[i]
+		expected := sym.Args[argName]
+		if expected == nil || expected.Name == nil {
+			continue
+		}
+
+		value := c.resolveConstValue(arg)
+		if ref, ok := value.(*ReferenceValueNode); ok && ref.Name != nil {
+			if sym.Name == "When" && argName == "value" {
+				continue
+			}
+
+			if expected.Name.Value != "Any" || !c.isEnumMemberName(ref.Name.Value) {
+				return exception.NewTypeException(fmt.Sprintf("Constant '%s' is not defined", ref.Name.Value), ref.Loc)
+			}
+			continue
+		}
+
+		if !isValueAssignableToType(value, expected.Name.Value) {
+			return exception.NewTypeException(
+				fmt.Sprintf("Annotation '%s' argument '%s' expects type '%s'", sym.Name, argName, expected.Name.Value),
+				arg.GetLocation(),
+			)
+		}
+	}
+
+	return nil
+}
+
+func (c *TypeChecker) CheckModelFieldType(node *ModelFieldDeclNode) exception.IException {
+	err := c.CheckType(node.Type)
+	if err != nil {
+		return err
+	}
+
+	err = c.CheckAnnotations(node.Annotations, AnnotationTargetField)
+	if err != nil {
+		return err
+	}
+
+	for _, annotation := range node.Annotations {
+		sym := c.Context.GetAnnotationByName(annotation.Name.Value)
+		if sym != nil && len(sym.FieldTypes) > 0 && !c.fieldTypeMatches(node.Type, sym.FieldTypes) {
+			return exception.NewTypeException(
+				fmt.Sprintf("Validator '%s' cannot be used on field of type '%s'", sym.Name, FormatTypeDecl(node.Type)),
+				annotation.Loc,
+			)
+		}
+
+		if err := c.CheckValidatorArgs(annotation, node); err != nil {
+			return err
+		}
+	}
+
+	if hasAnnotationNode(node.Annotations, "NestedValidate") && !c.isModelTypeOrArrayOfModel(node.Type) {
+		return exception.NewTypeException("Annotation 'NestedValidate' can only be used on model or Array<Model> fields", node.Type.Loc)
+	}
+
+	if node.DefaultValue != nil {
+		if err := c.CheckDefaultValue(node); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *TypeChecker) CheckDefaultValue(node *ModelFieldDeclNode) exception.IException {
+	if _, ok := node.DefaultValue.(*NullValueNode); ok {
+		if node.Optional || isNullType(node.Type) {
+			return nil
+		}
+
+		return exception.NewTypeException(
+			fmt.Sprintf("Default value of field '%s' cannot be null unless the field is optional", node.Name.Value),
+			node.DefaultValue.GetLocation(),
+		)
+	}
+
+	sym := c.Context.GetTypeByName(node.Type.Name.Value)
+	if sym != nil && (sym.DeclKind == TypeDeclKindModel || sym.DeclKind == TypeDeclKindGeneric) {
+		return exception.NewTypeException(
+			fmt.Sprintf("Field '%s' of type '%s' cannot have a default value", node.Name.Value, FormatTypeDecl(node.Type)),
+			node.DefaultValue.GetLocation(),
+		)
+	}
+
+	if !c.isValueAssignableToTypeDecl(node.DefaultValue, node.Type) {
+		return exception.NewTypeException(
+			fmt.Sprintf("Default value of field '%s' expects type '%s'", node.Name.Value, FormatTypeDecl(node.Type)),
+			node.DefaultValue.GetLocation(),
+		)
+	}
+
+	return nil
+}
+
+func (c *TypeChecker) CheckModelType(node *ModelDeclNode) exception.IException {
+	if node.Name == nil {
+		return exception.NewTypeException("Model name is missing", node.Loc)
+	}
+
+	ctx_ := c.Context
+	c.Context = NewContext(c.Context)
+
+	for _, typeVar := range node.Generics {
 		sym := NewGenericTypeSymbol(typeVar.Name.Value)
+		sym.Decl = typeVar
 		if c.Context.Find(sym) {
 			return exception.NewTypeException(fmt.Sprintf("Type parameter '%s' is already defined", sym.Name), typeVar.Name.Loc)
 		}
 
-		c.Context.Add(sym)
+		c.Context.Add(sym)
+	}
+
+	if err := c.CheckTypeParams(node.Generics); err != nil {
+		return err
+	}
+
+	if err := c.CheckModelHierarchy(node); err != nil {
+		return err
+	}
+
+	for _, field := range node.Fields {
+		err := c.CheckModelFieldType(field)
+		if err != nil {
+			return err
+		}
+	}
+
+	err := c.CheckAnnotations(node.Annotations, AnnotationTargetModel)
+	if err != nil {
+		return err
+	}
+
+	if err := c.CheckModelValidators(node); err != nil {
+		return err
+	}
+
+	if err := c.CheckFieldConditions(node); err != nil {
+		return err
+	}
+
+	c.Context = ctx_
+
+	return nil
+}
+
+func (c *TypeChecker) CheckModelValidators(node *ModelDeclNode) exception.IException {
+	fields, err := c.modelFields(node, map[string]bool{})
+	if err != nil {
+		return err
+	}
+
+	for _, annotation := range node.Annotations {
+		switch annotation.Name.Value {
+		case "EqualsField":
+			field, err := c.validatorField(annotation, 0, fields)
+			if err != nil {
+				return err
+			}
+
+			other, err := c.validatorField(annotation, 1, fields)
+			if err != nil {
+				return err
+			}
+
+			if FormatTypeDecl(field.Type) != FormatTypeDecl(other.Type) {
+				return exception.NewTypeException(
+					fmt.Sprintf("Annotation 'EqualsField' compares field '%s' of type '%s' with field '%s' of type '%s'", field.Name.Value, FormatTypeDecl(field.Type), other.Name.Value, FormatTypeDecl(other.Type)),
+					annotation.Loc,
+				)
+			}
+		case "Compare":
+			field, err := c.validatorField(annotation, 0, fields)
+			if err != nil {
+				return err
+			}
+
+			other, err := c.validatorField(annotation, 2, fields)
+			if err != nil {
+				return err
+			}
+
+			operator, ok := c.resolveConstValue(annotation.Args[1]).(*StringValueNode)
+			if !ok || !isCompareOperator(operator.Value) {
+				return exception.NewTypeException("Annotation 'Compare' operator must be one of <, <=, >, >=, ==, !=", annotation.Args[1].GetLocation())
+			}
+
+			fieldKind := comparableKind(field.Type)
+			if fieldKind == "" || fieldKind != comparableKind(other.Type) {
+				return exception.NewTypeException(
+					fmt.Sprintf("Annotation 'Compare' cannot compare field '%s' of type '%s' with field '%s' of type '%s'", field.Name.Value, FormatTypeDecl(field.Type), other.Name.Value, FormatTypeDecl(other.Type)),
+					annotation.Loc,
+				)
+			}
+		case "OneOf":
+			names, ok := c.resolveConstValue(annotation.Args[0]).(*ArrayValueNode)
+			if !ok || len(names.Values) < 2 {
+				return exception.NewTypeException("Annotation 'OneOf' expects at least two field names", annotation.Args[0].GetLocation())
+			}
+
+			for _, item := range names.Values {
+				name, ok := c.resolveConstValue(item).(*StringValueNode)
+				if !ok {
+					return exception.NewTypeException("Annotation 'OneOf' field names must be strings", item.GetLocation())
+				}
+
+				field := findField(fields, name.Value)
+				if field == nil {
+					return exception.NewTypeException(fmt.Sprintf("Annotation 'OneOf' refers to unknown field '%s'", name.Value), item.GetLocation())
+				}
+
+				if !field.Optional {
+					return exception.NewTypeException(fmt.Sprintf("Field '%s' used in 'OneOf' must be optional", name.Value), item.GetLocation())
+				}
+			}
+		}
+
+		sym := c.Context.GetAnnotationByName(annotation.Name.Value)
+		if sym == nil || !sym.Optional["key"] || len(annotation.Args) < len(sym.ArgOrder) {
+			continue
+		}
+
+		keyIndex := len(sym.ArgOrder) - 1
+		if key, ok := c.resolveConstValue(annotation.Args[keyIndex]).(*StringValueNode); ok && key.Value == "_model" {
+			continue
+		}
+
+		if _, err := c.validatorField(annotation, keyIndex, fields); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *TypeChecker) CheckFieldConditions(node *ModelDeclNode) exception.IException {
+	fields, err := c.modelFields(node, map[string]bool{})
+	if err != nil {
+		return err
+	}
+
+	if err := c.checkFieldConditions(node.Fields, fields); err != nil {
+		return err
+	}
+
+	own := make(map[*ModelFieldDeclNode]bool, len(node.Fields))
+	for _, field := range node.Fields {
+		own[field] = true
+	}
+
+	for _, field := range fields {
+		if own[field] || field.Name == nil {
+			continue
+		}
+
+		for _, annotation := range field.Annotations {
+			if annotation.Name.Value != "When" || len(annotation.Args) == 0 {
+				continue
+			}
+
+			name, ok := c.resolveConstValue(annotation.Args[0]).(*StringValueNode)
+			if ok && findField(fields, name.Value) == nil {
+				return exception.NewTypeException(
+					fmt.Sprintf("Model '%s' keeps field '%s' but not field '%s' that its 'When' condition refers to", node.Name.Value, field.Name.Value, name.Value),
+					node.Name.Loc,
+				)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c *TypeChecker) checkFieldConditions(own []*ModelFieldDeclNode, fields []*ModelFieldDeclNode) exception.IException {
+	for _, field := range own {
+		if field == nil || field.Name == nil {
+			continue
+		}
+
+		var condition *AnnotationNode
+		guarded := true
+		for _, annotation := range field.Annotations {
+			if annotation.Name.Value != "When" {
+				if sym := c.Context.GetAnnotationByName(annotation.Name.Value); sym != nil && sym.IsValidator {
+					guarded = true
+				}
+				continue
+			}
+
+			if condition != nil {
+				return exception.NewTypeException(fmt.Sprintf("Field '%s' has more than one 'When' annotation", field.Name.Value), annotation.Loc)
+			}
+			condition = annotation
+			guarded = false
+
+			target, err := c.validatorField(annotation, 0, fields)
+			if err != nil {
+				return err
+			}
+
+			value := c.resolveConstValue(annotation.Args[1])
+			if _, ok := value.(*NullValueNode); ok && target.Optional {
+				continue
+			}
+
+			if ref, ok := value.(*ReferenceValueNode); ok && ref.Name != nil && !c.isValueAssignableToTypeDecl(value, target.Type) {
+				if enum := c.enumTypeSymbol(target.Type); enum != nil {
+					return exception.NewTypeException(
+						fmt.Sprintf("'%s' is not a member of enum '%s' of field '%s'", ref.Name.Value, enum.Name, target.Name.Value),
+						ref.Loc,
+					)
+				}
+
+				if !c.isEnumMemberName(ref.Name.Value) {
+					return exception.NewTypeException(fmt.Sprintf("Constant '%s' is not defined", ref.Name.Value), ref.Loc)
+				}
+			}
+
+			if !c.isValueAssignableToTypeDecl(value, target.Type) && !c.isEnumValueLiteral(value, target.Type) {
+				return exception.NewTypeException(
+					fmt.Sprintf("Annotation 'When' value %s does not match type '%s' of field '%s'", FormatValueNode(annotation.Args[1]), FormatTypeDecl(target.Type), target.Name.Value),
+					annotation.Args[1].GetLocation(),
+				)
+			}
+		}
+
+		if condition != nil && !guarded {
+			return exception.NewTypeException(fmt.Sprintf("Annotation 'When' on field '%s' is not followed by any validator", field.Name.Value), condition.Loc)
+		}
+	}
+
+	return nil
+}
+
+func (c *TypeChecker) isEnumValueLiteral(value ASTValueNode, node *TypeDeclNode) bool {
+	if node == nil || node.Name == nil {
+		return false
+	}
+
+	sym := c.Context.GetTypeByName(node.Name.Value)
+	if sym == nil || sym.DeclKind != TypeDeclKindEnum {
+		return false
+	}
+
+	decl, ok := sym.Decl.(*EnumDeclNode)
+	if !ok {
+		return false
+	}
+
+	literal := ""
+	switch v := value.(type) {
+	case *StringValueNode:
+		if EnumValueKind(decl) != "String" {
+			return false
+		}
+		literal = v.Value
+	case *NumberValueNode:
+		if EnumValueKind(decl) != "Int" {
+			return false
+		}
+		literal = v.Value
+	default:
+		return false
+	}
+
+	for _, member := range decl.Members {
+		if member == nil || member.Name == nil {
+			continue
+		}
+
+		memberValue := member.Name.Value
+		switch v := member.Value.(type) {
+		case *StringValueNode:
+			memberValue = v.Value
+		case *NumberValueNode:
+			memberValue = v.Value
+		}
+
+		if memberValue == literal {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *TypeChecker) validatorField(node *AnnotationNode, index int, fields []*ModelFieldDeclNode) (*ModelFieldDeclNode, exception.IException) {
+	arg := node.Args[index]
+	name, ok := c.resolveConstValue(arg).(*StringValueNode)
+	if !ok {
+		return nil, exception.NewTypeException(fmt.Sprintf("Annotation '%s' expects a field name", node.Name.Value), arg.GetLocation())
+	}
+
+	field := findField(fields, name.Value)
+	if field == nil {
+		return nil, exception.NewTypeException(fmt.Sprintf("Annotation '%s' refers to unknown field '%s'", node.Name.Value, name.Value), arg.GetLocation())
+	}
+
+	return field, nil
+}
+
+func isCompareOperator(value string) bool {
+	switch value {
+	case "<", "<=", ">", ">=", "==", "!=":
+		return true
+	default:
+		return false
+	}
+}
+
+func comparableKind(node *TypeDeclNode) string {
+	if node == nil || node.Name == nil {
+		return ""
+	}
+
+	switch node.Name.Value {
+	case "Int", "Float":
+		return "number"
+	case "String":
+		return "string"
+	default:
+		return ""
+	}
+}
+
+func (c *TypeChecker) CheckModelHierarchy(node *ModelDeclNode) exception.IException {
+	if node.Derived != nil {
+		if err := c.checkModelReference(modelExprRef(node.Derived), "derive from"); err != nil {
+			return err
+		}
+
+		if err := c.checkModelCycle(node, []string{node.Name.Value}); err != nil {
+			return err
+		}
+
+		_, err := c.checkModelExpr(node.Derived, map[string]bool{})
+		return err
+	}
+
+	if node.Extends != nil {
+		if err := c.checkModelReference(node.Extends, "extend"); err != nil {
+			return err
+		}
+	}
+
+	for _, spread := range node.Spreads {
+		if err := c.checkModelReference(spread.Type, "spread"); err != nil {
+			return err
+		}
+	}
+
+	if err := c.checkModelCycle(node, []string{node.Name.Value}); err != nil {
+		return err
+	}
+
+	owners := make(map[string]string)
+	if node.Extends != nil {
+		parent := c.modelDecl(node.Extends.Name.Value)
+		parentFields, err := c.modelFields(parent, map[string]bool{})
+		if err != nil {
+			return err
+		}
+
+		for _, field := range parentFields {
+			owners[field.Name.Value] = parent.Name.Value
+		}
+	}
+
+	for _, spread := range node.Spreads {
+		source := c.modelDecl(spread.Type.Name.Value)
+		sourceFields, err := c.modelFields(source, map[string]bool{})
+		if err != nil {
+			return err
+		}
+
+		for _, field := range sourceFields {
+			if owner, exists := owners[field.Name.Value]; exists {
+				return exception.NewTypeException(
+					fmt.Sprintf("Field '%s' from '%s' conflicts with field from '%s'", field.Name.Value, source.Name.Value, owner),
+					spread.Loc,
+				)
+			}
+
+			owners[field.Name.Value] = source.Name.Value
+		}
+	}
+
+	for _, field := range node.Fields {
+		if field == nil || field.Name == nil {
+			continue
+		}
+
+		if owner, exists := owners[field.Name.Value]; exists {
+			if owner == node.Name.Value {
+				return exception.NewTypeException(fmt.Sprintf("Field '%s' is already defined", field.Name.Value), field.Name.Loc)
+			}
+
+			return exception.NewTypeException(
+				fmt.Sprintf("Field '%s' conflicts with field from '%s'", field.Name.Value, owner),
+				field.Name.Loc,
+			)
+		}
+
+		owners[field.Name.Value] = node.Name.Value
+	}
+
+	return nil
+}
+
+func (c *TypeChecker) checkModelExpr(node *ModelExprNode, visited map[string]bool) ([]*ModelFieldDeclNode, exception.IException) {
+	if node.Utility == nil {
+		return c.modelFields(c.modelDecl(node.Ref.Name.Value), visited)
+	}
+
+	fields, err := c.checkModelExpr(node.Operand, visited)
+	if err != nil {
+		return nil, err
+	}
+
+	utility := node.Utility.Value
+	switch utility {
+	case "Partial", "Required":
+		if len(node.Keys) > 0 {
+			return nil, exception.NewTypeException(fmt.Sprintf("'%s' does not take field names", utility), node.Keys[0].Loc)
+		}
+		return fields, nil
+	}
+
+	if len(node.Keys) == 0 {
+		return nil, exception.NewTypeException(fmt.Sprintf("'%s' expects at least one field name", utility), node.Loc)
+	}
+
+	keys := make(map[string]bool, len(node.Keys))
+	for _, key := range node.Keys {
+		if keys[key.Value] {
+			return nil, exception.NewTypeException(fmt.Sprintf("Field '%s' is listed more than once", key.Value), key.Loc)
+		}
+
+		if findField(fields, key.Value) == nil {
+			return nil, exception.NewTypeException(
+				fmt.Sprintf("Field '%s' does not exist on '%s'", key.Value, FormatModelExpr(node.Operand)),
+				key.Loc,
+			)
+		}
+
+		keys[key.Value] = true
+	}
+
+	result := make([]*ModelFieldDeclNode, 0, len(fields))
+	for _, field := range fields {
+		if keys[field.Name.Value] == (utility == "Pick") {
+			result = append(result, field)
+		}
+	}
+
+	return result, nil
+}
+
+func modelExprRef(node *ModelExprNode) *TypeDeclNode {
+	for node != nil && node.Utility != nil {
+		node = node.Operand
+	}
+
+	if node == nil {
+		return nil
+	}
+
+	return node.Ref
+}
+
+func findField(fields []*ModelFieldDeclNode, name string) *ModelFieldDeclNode {
+	for _, field := range fields {
+		if field != nil && field.Name != nil && field.Name.Value == name {
+			return field
+		}
+	}
+
+	return nil
+}
+
+func (c *TypeChecker) checkModelReference(node *TypeDeclNode, verb string) exception.IException {
+	if err := c.CheckType(node); err != nil {
+		return err
+	}
+
+	sym := c.Context.GetTypeByName(node.Name.Value)
+	if sym == nil || sym.DeclKind != TypeDeclKindModel {
+		return exception.NewTypeException(
+			fmt.Sprintf("Can only %s a model, '%s' is not a model", verb, node.Name.Value),
+			node.Loc,
+		)
+	}
+
+	return nil
+}
+
+func (c *TypeChecker) checkModelCycle(node *ModelDeclNode, path []string) exception.IException {
+	for _, ref := range modelParentRefs(node) {
+		for _, name := range path {
+			if name == ref.Name.Value {
+				return exception.NewTypeException(
+					fmt.Sprintf("Cyclic model inheritance: %s -> %s", strings.Join(path, " -> "), ref.Name.Value),
+					ref.Loc,
+				)
+			}
+		}
+
+		parent := c.modelDecl(ref.Name.Value)
+		if parent == nil {
+			continue
+		}
+
+		if err := c.checkModelCycle(parent, append(path, ref.Name.Value)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *TypeChecker) modelDecl(name string) *ModelDeclNode {
+	sym := c.Context.GetTypeByName(name)
+	if sym == nil || sym.DeclKind != TypeDeclKindModel {
+		return nil
+	}
+
+	decl, ok := sym.Decl.(*ModelDeclNode)
+	if !ok {
+		return nil
+	}
+
+	return decl
+}
+
+func (c *TypeChecker) modelFields(node *ModelDeclNode, visited map[string]bool) ([]*ModelFieldDeclNode, exception.IException) {
+	if node == nil || node.Name == nil || visited[node.Name.Value] {
+		return nil, nil
+	}
+	visited[node.Name.Value] = true
+
+	if node.Derived != nil {
+		return c.checkModelExpr(node.Derived, visited)
+	}
+
+	fields := make([]*ModelFieldDeclNode, 0, len(node.Fields))
+	for _, ref := range modelParentRefs(node) {
+		parentFields, err := c.modelFields(c.modelDecl(ref.Name.Value), visited)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, parentFields...)
+	}
+
+	for _, field := range node.Fields {
+		if field != nil && field.Name != nil {
+			fields = append(fields, field)
+		}
+	}
+
+	return fields, nil
+}
+
+func modelParentRefs(node *ModelDeclNode) []*TypeDeclNode {
+	refs := make([]*TypeDeclNode, 0, len(node.Spreads)+1)
+	if ref := modelExprRef(node.Derived); ref != nil && ref.Name != nil {
+		refs = append(refs, ref)
+	}
+
+	if node.Extends != nil && node.Extends.Name != nil {
+		refs = append(refs, node.Extends)
+	}
+
+	for _, spread := range node.Spreads {
+		if spread != nil && spread.Type != nil && spread.Type.Name != nil {
+			refs = append(refs, spread.Type)
+		}
+	}
+
+	return refs
+}
+
+func (c *TypeChecker) CheckEnumType(node *EnumDeclNode) exception.IException {
+	if node == nil {
+		fallbackLoc := NewLocation("<unknown>", NewPosition(1, 1), NewPosition(1, 1))
+		return exception.NewTypeException("Enum name is missing", fallbackLoc)
+	}
+
+	if node.Name == nil {
+		return exception.NewTypeException("Enum name is missing", node.Loc)
+	}
+
+	if len(node.Members) == 0 {
+		return exception.NewTypeException("Enum must have at least one member", node.Loc)
+	}
+
+	if err := c.CheckAnnotations(node.Annotations, AnnotationTargetEnum); err != nil {
+		return err
+	}
+
+	valueKind := EnumValueKind(node)
+	seen := make(map[string]struct{}, len(node.Members))
+	seenValues := make(map[string]struct{}, len(node.Members))
+	for _, member := range node.Members {
+		if member == nil || member.Name == nil {
+			continue
+		}
+
+		if _, exists := seen[member.Name.Value]; exists {
+			return exception.NewTypeException(fmt.Sprintf("Enum member '%s' is already defined", member.Name.Value), member.Name.Loc)
+		}
+
+		seen[member.Name.Value] = struct{}{}
+
+		if err := c.CheckAnnotations(member.Annotations, AnnotationTargetEnumMember); err != nil {
+			return err
+		}
+
+		value := member.Name.Value
+		switch v := member.Value.(type) {
+		case nil:
+			if valueKind == "Int" {
+				return exception.NewTypeException(
+					fmt.Sprintf("Enum member '%s' needs an explicit value because '%s' is an integer enum", member.Name.Value, node.Name.Value),
+					member.Loc,
+				)
+			}
+		case *StringValueNode:
+			if valueKind == "Int" {
+				return exception.NewTypeException(fmt.Sprintf("Enum member '%s' must have an integer value", member.Name.Value), v.Loc)
+			}
+			value = v.Value
+		case *NumberValueNode:
+			if !isValueAssignableToType(v, "Int") {
+				return exception.NewTypeException(fmt.Sprintf("Enum member '%s' must have an integer value", member.Name.Value), v.Loc)
+			}
+			value = v.Value
+		default:
+			return exception.NewTypeException(
+				fmt.Sprintf("Enum member '%s' value must be a string or integer literal", member.Name.Value),
+				member.Value.GetLocation(),
+			)
+		}
+
+		if _, exists := seenValues[value]; exists {
+			return exception.NewTypeException(fmt.Sprintf("Enum value '%s' is already used by another member", value), member.Loc)
+		}
+
+		seenValues[value] = struct{}{}
+	}
+
+	return nil
+}
+
+func EnumValueKind(node *EnumDeclNode) string {
+	if node == nil {
+		return "String"
+	}
+
+	for _, member := range node.Members {
+		if member == nil {
+			continue
+		}
+
+		if _, ok := member.Value.(*NumberValueNode); ok {
+			return "Int"
+		}
+	}
+
+	return "String"
+}
+
+func (c *TypeChecker) CheckRestType(node *RestDeclNode) exception.IException {
+	if node == nil {
+		fallbackLoc := NewLocation("<unknown>", NewPosition(1, 1), NewPosition(1, 1))
+		return exception.NewTypeException("Rest name is missing", fallbackLoc)
+	}
+
+	if node.Name == nil {
+		return exception.NewTypeException("Rest name is missing", node.Loc)
+	}
+
+	if err := c.CheckAnnotations(node.Annotations, AnnotationTargetRest); err != nil {
+		return err
+	}
+
+	if node.MethodValue == nil {
+		return exception.NewTypeException("Rest property 'method' is required", node.Loc)
+	}
+	if node.PathValue == nil {
+		return exception.NewTypeException("Rest property 'path' is required", node.Loc)
+	}
+
+	methodValue, ok := node.MethodValue.(*StringValueNode)
+	if !ok {
+		return exception.NewTypeException("Rest property 'method' must be a string literal", node.MethodValue.GetLocation())
+	}
+
+	if !isHTTPMethod(methodValue.Value) {
+		return exception.NewTypeException("Rest property 'method' must be one of GET, POST, PUT, PATCH, DELETE", methodValue.Loc)
+	}
+
+	httpMethod := strings.ToUpper(methodValue.Value)
+
+	pathValue, ok := node.PathValue.(*StringValueNode)
+	if !ok {
+		return exception.NewTypeException("Rest property 'path' must be a string literal", node.PathValue.GetLocation())
+	}
+
+	if node.Service != nil {
+		if basePath, ok := node.Service.BasePathValue.(*StringValueNode); ok {
+			pathValue = &StringValueNode{Value: JoinRestPath(basePath.Value, pathValue.Value), Loc: pathValue.Loc}
+		}
+	}
+
+	if err := c.CheckRestParams(node.Params, pathValue); err != nil {
+		return err
+	}
+
+	if node.RequestBodyType != nil {
+		if err := c.CheckType(node.RequestBodyType); err != nil {
+			return err
+		}
+
+		if !c.isUserDefinedType(node.RequestBodyType) && !isNullType(node.RequestBodyType) {
+			return exception.NewTypeException("Rest property 'requestBody' must be a user-defined type", node.RequestBodyType.Loc)
+		}
+
+		if httpMethod == "GET" && !isNullType(node.RequestBodyType) {
+			c.AddWarning("GET endpoint usually does not need requestBody", node.RequestBodyType.Loc)
+		}
+	}
+
+	if node.ResponseBodyType != nil {
+		if err := c.CheckType(node.ResponseBodyType); err != nil {
+			return err
+		}
+
+		if !c.isUserDefinedType(node.ResponseBodyType) && !isNullType(node.ResponseBodyType) {
+			return exception.NewTypeException("Rest property 'responseBody' must be a user-defined type", node.ResponseBodyType.Loc)
+		}
+	}
+
+	if node.QueriesValue != nil {
+		queriesValue, ok := node.QueriesValue.(*ArrayValueNode)
+		if !ok {
+			return exception.NewTypeException("Rest property 'queries' must be an array literal", node.QueriesValue.GetLocation())
+		}
+
+		for _, item := range queriesValue.Values {
+			if _, ok := item.(*StringValueNode); !ok {
+				return exception.NewTypeException("Rest property 'queries' must be string[]", item.GetLocation())
+			}
+		}
+	}
+
+	if node.Queries != nil {
+		if err := c.CheckRestQueries(node.Queries, node.Params); err != nil {
+			return err
+		}
+	}
+
+	if node.ResponsesLoc != nil {
+		if err := c.CheckRestResponses(node); err != nil {
+			return err
+		}
+	}
+
+	if node.ErrorsValue != nil {
+		if err := c.CheckRestErrors(node); err != nil {
+			return err
+		}
+	}
+
+	if node.Headers != nil {
+		if err := c.CheckRestHeaders(node.Headers, "Header"); err != nil {
+			return err
+		}
+	}
+
+	if node.ResponseHeaders != nil {
+		if err := c.CheckRestHeaders(node.ResponseHeaders, "Response header"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *TypeChecker) CheckServiceType(node *ServiceDeclNode) exception.IException {
+	if node.Name == nil {
+		return exception.NewTypeException("Service name is missing", node.Loc)
+	}
+
+	if err := c.CheckAnnotations(node.Annotations, AnnotationTargetService); err != nil {
+		return err
+	}
+
+	if node.BasePathValue != nil {
+		basePath, ok := node.BasePathValue.(*StringValueNode)
+		if !ok {
+			return exception.NewTypeException("Service property 'basePath' must be a string literal", node.BasePathValue.GetLocation())
+		}
+
+		if !strings.HasPrefix(basePath.Value, "/") {
+			return exception.NewTypeException("Service property 'basePath' must start with '/'", basePath.Loc)
+		}
+	}
+
+	if node.Headers != nil {
+		if err := c.CheckRestHeaders(node.Headers, "Header"); err != nil {
+			return err
+		}
+	}
+
+	if len(node.Rests) == 0 && len(node.Rpcs) == 0 {
+		c.AddWarning(fmt.Sprintf("Service '%s' does not declare any rest or rpc endpoint", node.Name.Value), node.Name.Loc)
+	}
+
+	routes := make(map[string]string, len(node.Rests))
+	for _, rest := range node.Rests {
+		if err := c.CheckRestType(rest); err != nil {
+			return err
+		}
+
+		method, _ := rest.MethodValue.(*StringValueNode)
+		path, _ := rest.PathValue.(*StringValueNode)
+		route := strings.ToUpper(method.Value) + " " + JoinRestPath("", path.Value)
+		if other, exists := routes[route]; exists {
+			return exception.NewTypeException(fmt.Sprintf("Rest '%s' has the same method and path as '%s'", rest.Name.Value, other), rest.Name.Loc)
+		}
+		routes[route] = rest.Name.Value
+	}
+
+	for _, rpc := range node.Rpcs {
+		if err := c.CheckRpcType(rpc); err != nil {
+			return err
+		}
+
+		route := "POST " + RpcBindingPath(node.Name.Value, rpc.Name.Value)
+		if other, exists := routes[route]; exists {
+			return exception.NewTypeException(fmt.Sprintf("Rpc '%s' has the same method and path as '%s'", rpc.Name.Value, other), rpc.Name.Loc)
+		}
+		routes[route] = rpc.Name.Value
+	}
+
+	return nil
+}
+
+func (c *TypeChecker) CheckStreamType(node *StreamDeclNode) exception.IException {
+	if err := c.CheckAnnotations(node.Annotations, AnnotationTargetStream); err != nil {
+		return err
+	}
+
+	if err := c.checkStreamingPath("Stream", node.PathValue, node.Params, node.Queries, node.Loc); err != nil {
+		return err
+	}
+
+	if node.EventType == nil {
+		return exception.NewTypeException("Stream property 'event' is required", node.Loc)
+	}
+
+	if err := c.CheckType(node.EventType); err != nil {
+		return err
+	}
+
+	if !c.isUserDefinedType(node.EventType) {
+		return exception.NewTypeException("Stream property 'event' must be a user-defined type", node.EventType.Loc)
+	}
+
+	return nil
+}
+
+func (c *TypeChecker) CheckChannelType(node *ChannelDeclNode) exception.IException {
+	if err := c.CheckAnnotations(node.Annotations, AnnotationTargetChannel); err != nil {
+		return err
+	}
+
+	if err := c.checkStreamingPath("Channel", node.PathValue, node.Params, node.Queries, node.Loc); err != nil {
+		return err
+	}
+
+	if len(node.ClientMessages) == 0 && len(node.ServerMessages) == 0 {
+		return exception.NewTypeException(fmt.Sprintf("Channel '%s' must declare client or server messages", node.Name.Value), node.Loc)
+	}
+
+	if err := c.checkChannelMessages("client", node.ClientMessages); err != nil {
+		return err
+	}
+
+	return c.checkChannelMessages("server", node.ServerMessages)
+}
+
+func (c *TypeChecker) checkStreamingPath(label string, pathNode ASTValueNode, params *FieldBlockNode, queries *FieldBlockNode, loc *Location) exception.IException {
+	if pathNode == nil {
+		return exception.NewTypeException(label+" property 'path' is required", loc)
+	}
+
+	path, ok := pathNode.(*StringValueNode)
+	if !ok {
+		return exception.NewTypeException(label+" property 'path' must be a string literal", pathNode.GetLocation())
+	}
+
+	if err := c.CheckRestParams(params, path); err != nil {
+		return err
+	}
+
+	if queries != nil {
+		if err := c.CheckRestQueries(queries, params); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *TypeChecker) checkChannelMessages(side string, messages []*TypeDeclNode) exception.IException {
+	seen := make(map[string]struct{}, len(messages))
+	for _, message := range messages {
+		if err := c.CheckType(message); err != nil {
+			return err
+		}
+
+		sym := c.Context.GetTypeByName(message.Name.Value)
+		if sym == nil || sym.DeclKind != TypeDeclKindModel {
+			return exception.NewTypeException(fmt.Sprintf("Channel %s message '%s' must be a model", side, FormatTypeDecl(message)), message.Loc)
+		}
+
+		if _, exists := seen[message.Name.Value]; exists {
+			return exception.NewTypeException(fmt.Sprintf("Channel %s message '%s' is listed more than once", side, message.Name.Value), message.Loc)
+		}
+		seen[message.Name.Value] = struct{}{}
+	}
+
+	return nil
+}
+
+func (c *TypeChecker) registerRpc(node *RpcDeclNode) exception.IException {
+	if node.Name == nil {
+		return exception.NewTypeException("Rpc name is missing", node.Loc)
+	}
+
+	sym := NewRpcSymbol(node.Name.Value, node)
+	if c.Context.Find(sym) {
+		return exception.NewTypeException(fmt.Sprintf("Name '%s' is already defined", sym.Name), node.Name.Loc)
+	}
+
+	c.Context.Add(sym)
+	return nil
+}
+
+func (c *TypeChecker) CheckRpcType(node *RpcDeclNode) exception.IException {
+	if node.Name == nil {
+		return exception.NewTypeException("Rpc name is missing", node.Loc)
+	}
+
+	if err := c.CheckAnnotations(node.Annotations, AnnotationTargetRpc); err != nil {
+		return err
+	}
+
+	if node.RequestType != nil {
+		if err := c.CheckType(node.RequestType); err != nil {
+			return err
+		}
+
+		if !c.isUserDefinedType(node.RequestType) && !isNullType(node.RequestType) {
+			return exception.NewTypeException(fmt.Sprintf("Request of rpc '%s' must be a user-defined type", node.Name.Value), node.RequestType.Loc)
+		}
+	}
+
+	if node.ResponseType == nil {
+		return exception.NewTypeException(fmt.Sprintf("Rpc '%s' must declare a response type", node.Name.Value), node.Loc)
+	}
+
+	if err := c.CheckType(node.ResponseType); err != nil {
+		return err
+	}
+
+	if !c.isUserDefinedType(node.ResponseType) && !isNullType(node.ResponseType) {
+		return exception.NewTypeException(fmt.Sprintf("Response of rpc '%s' must be a user-defined type", node.Name.Value), node.ResponseType.Loc)
+	}
+
+	return c.CheckDeclaredErrors(node.Throws)
+}
+
+func (c *TypeChecker) CheckRestResponses(node *RestDeclNode) exception.IException {
+	if node.ResponseBodyType != nil {
+		return exception.NewTypeException("Rest properties 'responseBody' and 'responses' cannot be used together", node.ResponsesLoc)
+	}
+
+	if len(node.Responses) == 0 {
+		return exception.NewTypeException("Rest property 'responses' must declare at least one status", node.ResponsesLoc)
+	}
+
+	seen := make(map[int]struct{}, len(node.Responses))
+	for _, response := range node.Responses {
+		status, err := strconv.Atoi(response.Status.Value)
+		if err != nil || status < 100 || status > 599 {
+			return exception.NewTypeException(fmt.Sprintf("Response status '%s' must be an integer between 100 and 599", response.Status.Value), response.Status.Loc)
+		}
+
+		if _, exists := seen[status]; exists {
+			return exception.NewTypeException(fmt.Sprintf("Response status %d is already declared", status), response.Status.Loc)
+		}
+		seen[status] = struct{}{}
+
+		if err := c.CheckType(response.Type); err != nil {
+			return err
+		}
+
+		if !c.isUserDefinedType(response.Type) && !isNullType(response.Type) {
+			return exception.NewTypeException(fmt.Sprintf("Response %d must be a user-defined type", status), response.Type.Loc)
+		}
+
+		if (status == 204 || status == 304) && !isNullType(response.Type) {
+			return exception.NewTypeException(fmt.Sprintf("Response %d cannot have a body", status), response.Type.Loc)
+		}
+
+		if status >= 400 {
+			c.AddWarning(fmt.Sprintf("Response %d is an error status; consider declaring it in 'errors'", status), response.Status.Loc)
+		}
+	}
+
+	return nil
+}
+
+func (c *TypeChecker) CheckRestErrors(node *RestDeclNode) exception.IException {
+	errorsValue, ok := node.ErrorsValue.(*ArrayValueNode)
+	if !ok {
+		return exception.NewTypeException("Rest property 'errors' must be an array literal", node.ErrorsValue.GetLocation())
+	}
+
+	names := make([]*IdentNode, 0, len(errorsValue.Values))
+	for _, item := range errorsValue.Values {
+		ref, ok := item.(*ReferenceValueNode)
+		if !ok || ref.Name == nil {
+			return exception.NewTypeException("Rest property 'errors' must be a list of error names", item.GetLocation())
+		}
+
+		names = append(names, ref.Name)
 	}
 
-	for _, field := range node.Fields {
-		err := c.CheckModelFieldType(field)
-		if err != nil {
+	return c.CheckDeclaredErrors(names)
+}
+
+func (c *TypeChecker) CheckDeclaredErrors(names []*IdentNode) exception.IException {
+	seen := make(map[string]struct{}, len(names))
+	statuses := make(map[string]string, len(names))
+	for _, name := range names {
+		sym := c.Context.GetErrorByName(name.Value)
+		if sym == nil {
+			return exception.NewTypeException(fmt.Sprintf("'%s' is not a declared error", name.Value), name.Loc)
+		}
+
+		if _, exists := seen[sym.Name]; exists {
+			return exception.NewTypeException(fmt.Sprintf("Error '%s' is listed more than once", sym.Name), name.Loc)
+		}
+		seen[sym.Name] = struct{}{}
+
+		if sym.Decl == nil || sym.Decl.StatusValue == nil {
+			continue
+		}
+
+		status := ""
+		switch v := sym.Decl.StatusValue.(type) {
+		case *StringValueNode:
+			status = v.Value
+		case *NumberValueNode:
+			status = v.Value
+		}
+
+		if other, exists := statuses[status]; exists {
+			c.AddWarning(fmt.Sprintf("Errors '%s' and '%s' share status %s", other, sym.Name, status), name.Loc)
+			continue
+		}
+		statuses[status] = sym.Name
+	}
+
+	return nil
+}
+
+func (c *TypeChecker) CheckRestHeaders(block *FieldBlockNode, label string) exception.IException {
+	declared := make(map[string]struct{}, len(block.Fields))
+	for _, field := range block.Fields {
+		if !IsHeaderName(field.Name.Value) {
+			return exception.NewTypeException(fmt.Sprintf("%s '%s' is not a valid header name", label, field.Name.Value), field.Name.Loc)
+		}
+
+		key := strings.ToLower(field.Name.Value)
+		if _, exists := declared[key]; exists {
+			return exception.NewTypeException(fmt.Sprintf("%s '%s' is already declared", label, field.Name.Value), field.Name.Loc)
+		}
+		declared[key] = struct{}{}
+
+		if err := c.CheckModelFieldType(field); err != nil {
 			return err
 		}
+
+		if !c.isScalarType(field.Type) {
+			return exception.NewTypeException(
+				fmt.Sprintf("%s '%s' must be a String, Int, Float, Bool or enum", label, field.Name.Value),
+				field.Type.Loc,
+			)
+		}
 	}
 
-	err := c.CheckAnnotations(node.Annotations)
-	if err != nil {
-		return err
+	return c.checkFieldConditions(block.Fields, block.Fields)
+}
+
+func (c *TypeChecker) CheckRestQueries(queries *FieldBlockNode, params *FieldBlockNode) exception.IException {
+	declared := make(map[string]struct{}, len(queries.Fields))
+	for _, field := range queries.Fields {
+		if _, exists := declared[field.Name.Value]; exists {
+			return exception.NewTypeException(fmt.Sprintf("Query parameter '%s' is already declared", field.Name.Value), field.Name.Loc)
+		}
+		declared[field.Name.Value] = struct{}{}
+
+		if err := c.CheckModelFieldType(field); err != nil {
+			return err
+		}
+
+		queryType := field.Type
+		if queryType != nil && queryType.Name != nil && queryType.Name.Value == "Array" && len(queryType.Generics) == 1 {
+			queryType = queryType.Generics[0]
+		}
+
+		if !c.isScalarType(queryType) {
+			return exception.NewTypeException(
+				fmt.Sprintf("Query parameter '%s' must be a String, Int, Float, Bool, enum or an Array of them", field.Name.Value),
+				field.Type.Loc,
+			)
+		}
 	}
 
-	c.Context = ctx_
+	if params != nil {
+		for _, field := range params.Fields {
+			if _, exists := declared[field.Name.Value]; exists {
+				c.AddWarning(fmt.Sprintf("Query parameter '%s' has the same name as a path parameter", field.Name.Value), field.Name.Loc)
+			}
+		}
+	}
 
-	return nil
+	return c.checkFieldConditions(queries.Fields, queries.Fields)
 }
 
-func (c *TypeChecker) CheckEnumType(node *EnumDeclNode) exception.IException {
-	if node == nil {
-		fallbackLoc := NewLocation("<unknown>", NewPosition(1, 1), NewPosition(1, 1))
-		return exception.NewTypeException("Enum name is missing", fallbackLoc)
+func (c *TypeChecker) CheckRestParams(params *FieldBlockNode, path *StringValueNode) exception.IException {
+	placeholders, parseErr := ParsePathParams(path.Value)
+	if parseErr != nil {
+		return exception.NewTypeException(fmt.Sprintf("Invalid path template: %s", parseErr.Error()), path.Loc)
 	}
 
-	if node.Name == nil {
-		return exception.NewTypeException("Enum name is missing", node.Loc)
+	used := make(map[string]struct{}, len(placeholders))
+	for _, name := range placeholders {
+		if _, exists := used[name]; exists {
+			return exception.NewTypeException(fmt.Sprintf("Path parameter '%s' appears more than once", name), path.Loc)
+		}
+		used[name] = struct{}{}
 	}
 
-	if len(node.Members) == 0 {
-		return exception.NewTypeException("Enum must have at least one member", node.Loc)
+	if params == nil {
+		for _, name := range placeholders {
+			c.AddWarning(fmt.Sprintf("Path parameter '%s' is not declared in a params block and is treated as String", name), path.Loc)
+		}
+		return nil
 	}
 
-	seen := make(map[string]struct{}, len(node.Members))
-	for _, member := range node.Members {
-		if member == nil {
-			continue
+	declared := make(map[string]struct{}, len(params.Fields))
+	for _, field := range params.Fields {
+		if _, exists := declared[field.Name.Value]; exists {
+			return exception.NewTypeException(fmt.Sprintf("Path parameter '%s' is already declared", field.Name.Value), field.Name.Loc)
+		}
+		declared[field.Name.Value] = struct{}{}
+
+		if err := c.CheckModelFieldType(field); err != nil {
+			return err
+		}
+
+		if field.Optional {
+			return exception.NewTypeException(fmt.Sprintf("Path parameter '%s' cannot be optional", field.Name.Value), field.Name.Loc)
+		}
+
+		if field.DefaultValue != nil {
+			return exception.NewTypeException(fmt.Sprintf("Path parameter '%s' cannot have a default value", field.Name.Value), field.DefaultValue.GetLocation())
+		}
+
+		if !c.isScalarType(field.Type) {
+			return exception.NewTypeException(
+				fmt.Sprintf("Path parameter '%s' must be a String, Int, Float, Bool or enum", field.Name.Value),
+				field.Type.Loc,
+			)
 		}
 
-		if _, exists := seen[member.Value]; exists {
-			return exception.NewTypeException(fmt.Sprintf("Enum member '%s' is already defined", member.Value), member.Loc)
+		if _, ok := used[field.Name.Value]; !ok {
+			return exception.NewTypeException(fmt.Sprintf("Path parameter '%s' is not used in path '%s'", field.Name.Value, path.Value), field.Name.Loc)
 		}
+	}
 
-		seen[member.Value] = struct{}{}
+	for _, name := range placeholders {
+		if _, ok := declared[name]; !ok {
+			return exception.NewTypeException(fmt.Sprintf("Path parameter '%s' is not declared in params", name), path.Loc)
+		}
 	}
 
-	return nil
+	return c.checkFieldConditions(params.Fields, params.Fields)
 }
 
-func (c *TypeChecker) CheckRestType(node *RestDeclNode) exception.IException {
+func (c *TypeChecker) isScalarType(node *TypeDeclNode) bool {
+	if node == nil || node.Name == nil {
+		return false
+	}
+
+	switch node.Name.Value {
+	case "String", "Int", "Float", "Bool":
+		return true
+	}
+
+	sym := c.Context.GetTypeByName(node.Name.Value)
+	return sym != nil && sym.DeclKind == TypeDeclKindEnum
+}
+
+func (c *TypeChecker) CheckErrorType(node *ErrorDeclNode) exception.IException {
 	if node == nil {
 		fallbackLoc := NewLocation("<unknown>", NewPosition(1, 1), NewPosition(1, 1))
-		return exception.NewTypeException("Rest name is missing", fallbackLoc)
+		return exception.NewTypeException("Error name is missing", fallbackLoc)
 	}
 
 	if node.Name == nil {
-		return exception.NewTypeException("Rest name is missing", node.Loc)
+		return exception.NewTypeException("Error name is missing", node.Loc)
 	}
 
-	if node.MethodValue == nil {
-		return exception.NewTypeException("Rest property 'method' is required", node.Loc)
+	if err := c.CheckAnnotations(node.Annotations, AnnotationTargetError); err != nil {
+		return err
 	}
-	if node.PathValue == nil {
-		return exception.NewTypeException("Rest property 'path' is required", node.Loc)
+
+	if node.MessageValue == nil {
+		return exception.NewTypeException("Error property 'message' is required", node.Loc)
 	}
 
-	methodValue, ok := node.MethodValue.(*StringValueNode)
-	if !ok {
-		return exception.NewTypeException("Rest property 'method' must be a string literal", node.MethodValue.GetLocation())
+	if _, ok := node.MessageValue.(*StringValueNode); !ok {
+		return exception.NewTypeException("Error property 'message' must be a string literal", node.MessageValue.GetLocation())
 	}
 
-	if !isHTTPMethod(methodValue.Value) {
-		return exception.NewTypeException("Rest property 'method' must be one of GET, POST, PUT, PATCH, DELETE", methodValue.Loc)
+	if node.CodeValue != nil {
+		if _, ok := node.CodeValue.(*StringValueNode); !ok {
+			return exception.NewTypeException("Error property 'code' must be a string literal", node.CodeValue.GetLocation())
+		}
 	}
 
-	httpMethod := strings.ToUpper(methodValue.Value)
+	if node.ScopeValue != nil {
+		if _, ok := node.ScopeValue.(*StringValueNode); !ok {
+			return exception.NewTypeException("Error property 'scope' must be a string literal", node.ScopeValue.GetLocation())
+		}
+	}
 
-	if _, ok := node.PathValue.(*StringValueNode); !ok {
-		return exception.NewTypeException("Rest property 'path' must be a string literal", node.PathValue.GetLocation())
+	if node.StatusValue != nil {
+		switch node.StatusValue.(type) {
+		case *StringValueNode, *NumberValueNode:
+			// status can be either quoted ("404") or numeric (404)
+		default:
+			return exception.NewTypeException("Error property 'status' must be a string or number literal", node.StatusValue.GetLocation())
+		}
 	}
 
-	if node.RequestBodyType != nil {
-		if err := c.CheckType(node.RequestBodyType); err != nil {
+	return nil
+}
+
+func (c *TypeChecker) Check(ast *ProgramNode) exception.IException {
+	c.Warnings = c.Warnings[:0]
+
+	if err := c.FindAllSymbol(ast); err != nil {
+		return err
+	}
+	for _, node := range ast.Body {
+		switch v := node.(type) {
+		case *ModelDeclNode:
+			err := c.CheckModelType(v)
+			if err != nil {
+				return err
+			}
+		case *EnumDeclNode:
+			err := c.CheckEnumType(v)
+			if err != nil {
+				return err
+			}
+		case *RestDeclNode:
+			err := c.CheckRestType(v)
+			if err != nil {
+				return err
+			}
+		case *ServiceDeclNode:
+			err := c.CheckServiceType(v)
+			if err != nil {
+				return err
+			}
+		case *RpcDeclNode:
+			err := c.CheckRpcType(v)
+			if err != nil {
+				return err
+			}
+		case *StreamDeclNode:
+			err := c.CheckStreamType(v)
+			if err != nil {
+				return err
+			}
+		case *ChannelDeclNode:
+			err := c.CheckChannelType(v)
+			if err != nil {
+				return err
+			}
+		case *EventDeclNode:
+			err := c.CheckEventType(v)
+			if err != nil {
+				return err
+			}
+		case *ErrorDeclNode:
+			err := c.CheckErrorType(v)
+			if err != nil {
+				return err
+			}
+		case *ConstDeclNode:
+			err := c.CheckConstType(v)
+			if err != nil {
+				return err
+			}
+		case *AnnotationDeclNode:
+			err := c.CheckAnnotationDecl(v)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	checkedEvents := make(map[string]bool)
+	for _, node := range ast.Body {
+		event, ok := node.(*EventDeclNode)
+		if !ok || event.Name == nil || checkedEvents[event.Name.Value] {
+			continue
+		}
+		checkedEvents[event.Name.Value] = true
+
+		if err := c.CheckEventVersions(c.Context.GetEventByName(event.Name.Value)); err != nil {
 			return err
 		}
+	}
 
-		if !c.isUserDefinedType(node.RequestBodyType) && !isNullType(node.RequestBodyType) {
-			return exception.NewTypeException("Rest property 'requestBody' must be a user-defined type", node.RequestBodyType.Loc)
+	return nil
+}
+
+func (c *TypeChecker) CheckEventVersions(sym *EventSymbol) exception.IException {
+	if sym == nil || len(sym.Versions) < 2 {
+		return nil
+	}
+
+	versions := make([]*EventDeclNode, len(sym.Versions))
+	copy(versions, sym.Versions)
+	sort.SliceStable(versions, func(i, j int) bool {
+		return EventVersion(versions[i]) < EventVersion(versions[j])
+	})
+
+	first := versions[0]
+	wireName := first.NameValue.(*StringValueNode).Value
+	for i, node := range versions[1:] {
+		previous := EventVersion(versions[i])
+		version := EventVersion(node)
+		if version == previous {
+			return exception.NewTypeException(fmt.Sprintf("Event '%s' v%d is already declared", sym.Name, version), node.Name.Loc)
 		}
 
-		if httpMethod == "GET" && !isNullType(node.RequestBodyType) {
-			c.AddWarning("GET endpoint usually does not need requestBody", node.RequestBodyType.Loc)
+		if version != previous+1 {
+			return exception.NewTypeException(
+				fmt.Sprintf("Event '%s' versions must be contiguous, v%d is missing", sym.Name, previous+1),
+				node.Name.Loc,
+			)
+		}
+
+		name := node.NameValue.(*StringValueNode).Value
+		if name != wireName {
+			return exception.NewTypeException(
+				fmt.Sprintf("Event '%s' v%d name '%s' does not match '%s' declared by v%d", sym.Name, version, name, wireName, EventVersion(first)),
+				node.NameValue.GetLocation(),
+			)
 		}
 	}
 
-	if node.ResponseBodyType != nil {
-		if err := c.CheckType(node.ResponseBodyType); err != nil {
+	return nil
+}
+
+func (c *TypeChecker) CheckAnnotationDecl(node *AnnotationDeclNode) exception.IException {
+	if node.Name == nil {
+		return exception.NewTypeException("Annotation name is missing", node.Loc)
+	}
+
+	seen := make(map[string]struct{}, len(node.Params))
+	for _, param := range node.Params {
+		if _, exists := seen[param.Name.Value]; exists {
+			return exception.NewTypeException(fmt.Sprintf("Annotation parameter '%s' is already defined", param.Name.Value), param.Name.Loc)
+		}
+		seen[param.Name.Value] = struct{}{}
+
+		if err := c.CheckType(param.Type); err != nil {
 			return err
 		}
 
-		if !c.isUserDefinedType(node.ResponseBodyType) && !isNullType(node.ResponseBodyType) {
-			return exception.NewTypeException("Rest property 'responseBody' must be a user-defined type", node.ResponseBodyType.Loc)
+		switch param.Type.Name.Value {
+		case "String", "Int", "Float", "Bool", "Array", "Any":
+		default:
+			return exception.NewTypeException(
+				fmt.Sprintf("Annotation parameter '%s' must be String, Int, Float, Bool, Array or Any", param.Name.Value),
+				param.Type.Loc,
+			)
 		}
 	}
 
-	if node.QueriesValue != nil {
-		queriesValue, ok := node.QueriesValue.(*ArrayValueNode)
-		if !ok {
-			return exception.NewTypeException("Rest property 'queries' must be an array literal", node.QueriesValue.GetLocation())
+	for _, forType := range node.ForTypes {
+		if err := c.CheckType(forType); err != nil {
+			return err
 		}
+	}
 
-		for _, item := range queriesValue.Values {
-			if _, ok := item.(*StringValueNode); !ok {
-				return exception.NewTypeException("Rest property 'queries' must be string[]", item.GetLocation())
-			}
+	targets := make(map[string]struct{}, len(node.Targets))
+	for _, target := range node.Targets {
+		if _, ok := annotationTargetKeywords[target.Value]; !ok {
+			return exception.NewTypeException(
+				fmt.Sprintf("Unknown annotation target '%s', expected one of model, field, enum, member, rest, event, error, const", target.Value),
+				target.Loc,
+			)
+		}
+
+		if _, exists := targets[target.Value]; exists {
+			c.AddWarning(fmt.Sprintf("Annotation target '%s' is listed more than once", target.Value), target.Loc)
 		}
+		targets[target.Value] = struct{}{}
 	}
 
 	return nil
 }
 
-func (c *TypeChecker) CheckErrorType(node *ErrorDeclNode) exception.IException {
-	if node == nil {
-		fallbackLoc := NewLocation("<unknown>", NewPosition(1, 1), NewPosition(1, 1))
-		return exception.NewTypeException("Error name is missing", fallbackLoc)
+func (c *TypeChecker) CheckConstType(node *ConstDeclNode) exception.IException {
+	if node.Name == nil {
+		return exception.NewTypeException("Constant name is missing", node.Loc)
 	}
 
-	if node.Name == nil {
-		return exception.NewTypeException("Error name is missing", node.Loc)
+	if err := c.CheckType(node.Type); err != nil {
+		return err
 	}
 
-	if node.MessageValue == nil {
-		return exception.NewTypeException("Error property 'message' is required", node.Loc)
+	if err := c.CheckAnnotations(node.Annotations, AnnotationTargetConst); err != nil {
+		return err
 	}
 
-	if _, ok := node.MessageValue.(*StringValueNode); !ok {
-		return exception.NewTypeException("Error property 'message' must be a string literal", node.MessageValue.GetLocation())
+	sym := c.Context.GetTypeByName(node.Type.Name.Value)
+	if sym != nil && (sym.DeclKind == TypeDeclKindModel || sym.DeclKind == TypeDeclKindGeneric) {
+		return exception.NewTypeException(
+			fmt.Sprintf("Constant '%s' cannot have type '%s'", node.Name.Value, FormatTypeDecl(node.Type)),
+			node.Type.Loc,
+		)
 	}
 
-	if node.CodeValue != nil {
-		if _, ok := node.CodeValue.(*StringValueNode); !ok {
-			return exception.NewTypeException("Error property 'code' must be a string literal", node.CodeValue.GetLocation())
+	path := []string{node.Name.Value}
+	visited := map[string]bool{node.Name.Value: true}
+	value := node.Value
+	for {
+		ref, ok := value.(*ReferenceValueNode)
+		if !ok || ref.Name == nil {
+			break
+		}
+
+		target := c.Context.GetConstByName(ref.Name.Value)
+		if target == nil {
+			break
 		}
-	}
 
-	if node.ScopeValue != nil {
-		if _, ok := node.ScopeValue.(*StringValueNode); !ok {
-			return exception.NewTypeException("Error property 'scope' must be a string literal", node.ScopeValue.GetLocation())
+		path = append(path, ref.Name.Value)
+		if visited[ref.Name.Value] {
+			return exception.NewTypeException(
+				fmt.Sprintf("Cyclic constant reference: %s", strings.Join(path, " -> ")),
+				node.Value.GetLocation(),
+			)
 		}
+
+		visited[ref.Name.Value] = true
+		value = target.Decl.Value
 	}
 
-	if node.StatusValue != nil {
-		switch node.StatusValue.(type) {
-		case *StringValueNode, *NumberValueNode:
-			// status can be either quoted ("404") or numeric (404)
-		default:
-			return exception.NewTypeException("Error property 'status' must be a string or number literal", node.StatusValue.GetLocation())
+	if _, ok := value.(*NullValueNode); ok && !isNullType(node.Type) {
+		return exception.NewTypeException(
+			fmt.Sprintf("Constant '%s' cannot be null", node.Name.Value),
+			node.Value.GetLocation(),
+		)
+	}
+
+	if !c.isValueAssignableToTypeDecl(node.Value, node.Type) {
+		if ref, ok := value.(*ReferenceValueNode); ok && ref.Name != nil && (sym == nil || sym.DeclKind != TypeDeclKindEnum) {
+			return exception.NewTypeException(fmt.Sprintf("Constant '%s' is not defined", ref.Name.Value), ref.Loc)
 		}
+
+		return exception.NewTypeException(
+			fmt.Sprintf("Constant '%s' expects type '%s'", node.Name.Value, FormatTypeDecl(node.Type)),
+			node.Value.GetLocation(),
+		)
 	}
 
 	return nil
 }
 
-func (c *TypeChecker) Check(ast *ProgramNode) exception.IException {
-	c.Warnings = c.Warnings[:0]
+func (c *TypeChecker) resolveConstValue(value ASTValueNode) ASTValueNode {
+	visited := make(map[string]bool)
+	for {
+		ref, ok := value.(*ReferenceValueNode)
+		if !ok || ref.Name == nil || visited[ref.Name.Value] {
+			return value
+		}
 
-	if err := c.FindAllSymbol(ast); err != nil {
-		return err
-	}
-	for _, node := range ast.Body {
-		switch v := node.(type) {
-		case *ModelDeclNode:
-			err := c.CheckModelType(v)
-			if err != nil {
-				return err
-			}
-		case *EnumDeclNode:
-			err := c.CheckEnumType(v)
-			if err != nil {
-				return err
-			}
-		case *RestDeclNode:
-			err := c.CheckRestType(v)
-			if err != nil {
-				return err
-			}
-		case *EventDeclNode:
-			err := c.CheckEventType(v)
-			if err != nil {
-				return err
-			}
-		case *ErrorDeclNode:
-			err := c.CheckErrorType(v)
-			if err != nil {
-				return err
-			}
+		sym := c.Context.GetConstByName(ref.Name.Value)
+		if sym == nil || sym.Decl == nil {
+			return value
 		}
-	}
 
-	return nil
+		visited[ref.Name.Value] = true
+		value = sym.Decl.Value
+	}
 }
 
 func (c *TypeChecker) CheckEventType(node *EventDeclNode) exception.IException {
@@ -658,6 +2607,10 @@ func (c *TypeChecker) CheckEventType(node *EventDeclNode) exception.IException {
 		return exception.NewTypeException("Event name is missing", node.Loc)
 	}
 
+	if err := c.CheckAnnotations(node.Annotations, AnnotationTargetEvent); err != nil {
+		return err
+	}
+
 	if node.PayloadType == nil {
 		return exception.NewTypeException("Event property 'payload' is required", node.Loc)
 	}
@@ -674,9 +2627,100 @@ func (c *TypeChecker) CheckEventType(node *EventDeclNode) exception.IException {
 		return err
 	}
 
+	if node.TopicValue != nil {
+		topic, ok := node.TopicValue.(*StringValueNode)
+		if !ok {
+			return exception.NewTypeException("Event property 'topic' must be a string literal", node.TopicValue.GetLocation())
+		}
+
+		if strings.TrimSpace(topic.Value) == "" {
+			return exception.NewTypeException("Event property 'topic' must not be empty", topic.Loc)
+		}
+	}
+
+	if node.VersionValue != nil {
+		version, ok := node.VersionValue.(*NumberValueNode)
+		if !ok {
+			return exception.NewTypeException("Event property 'version' must be an integer literal", node.VersionValue.GetLocation())
+		}
+
+		if n, err := strconv.Atoi(version.Value); err != nil || n < 1 {
+			return exception.NewTypeException("Event property 'version' must be a positive integer", version.Loc)
+		}
+	}
+
+	if node.DataSchemaValue != nil {
+		dataSchema, ok := node.DataSchemaValue.(*StringValueNode)
+		if !ok {
+			return exception.NewTypeException("Event property 'dataschema' must be a string literal", node.DataSchemaValue.GetLocation())
+		}
+
+		if parsed, err := url.Parse(dataSchema.Value); err != nil || !parsed.IsAbs() {
+			return exception.NewTypeException("Event property 'dataschema' must be an absolute URI", dataSchema.Loc)
+		}
+	}
+
+	if node.Headers != nil {
+		if err := c.CheckRestHeaders(node.Headers, "Event header"); err != nil {
+			return err
+		}
+	}
+
+	if node.KeyValue != nil {
+		if err := c.CheckEventKey(node); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+func (c *TypeChecker) CheckEventKey(node *EventDeclNode) exception.IException {
+	var keyName string
+	switch v := node.KeyValue.(type) {
+	case *ReferenceValueNode:
+		keyName = v.Name.Value
+	case *StringValueNode:
+		keyName = v.Value
+	default:
+		return exception.NewTypeException("Event property 'key' must name a payload field", node.KeyValue.GetLocation())
+	}
+
+	payload := c.modelDecl(node.PayloadType.Name.Value)
+	if payload == nil {
+		return exception.NewTypeException("Event property 'key' requires a model payload", node.KeyValue.GetLocation())
+	}
+
+	payloadFields, err := c.modelFields(payload, map[string]bool{})
+	if err != nil {
+		return err
+	}
+
+	for _, field := range payloadFields {
+		if field.Name.Value != keyName {
+			continue
+		}
+
+		if !c.isScalarType(field.Type) {
+			return exception.NewTypeException(
+				fmt.Sprintf("Event key '%s' must be a String, Int, Float, Bool or enum", keyName),
+				node.KeyValue.GetLocation(),
+			)
+		}
+
+		if field.Optional {
+			c.AddWarning(fmt.Sprintf("Event key '%s' is optional, events without it have no partition key", keyName), node.KeyValue.GetLocation())
+		}
+
+		return nil
+	}
+
+	return exception.NewTypeException(
+		fmt.Sprintf("'%s' is not a field of event payload '%s'", keyName, node.PayloadType.Name.Value),
+		node.KeyValue.GetLocation(),
+	)
+}
+
 func NewTypeChecker() *TypeChecker {
 	ctx := NewContext(nil)
 	ctx.Add(NewTypeSymbol("String", true))
@@ -691,46 +2735,75 @@ func NewTypeChecker() *TypeChecker {
 	ctx.Add(arrayType)
 
 	createConstructor := NewAnnotationSymbol("CreateConstructor", true)
+	createConstructor.Targets = append(createConstructor.Targets, AnnotationTargetModel)
 	ctx.Add(createConstructor)
 
 	mapper := NewAnnotationSymbol("Mapper", true)
+	mapper.Targets = append(mapper.Targets, AnnotationTargetModel)
 	ctx.Add(mapper)
 
+	deprecated := NewAnnotationSymbol("Deprecated", true)
+	deprecated.Targets = append(deprecated.Targets, allAnnotationTargets...)
+	ctx.Add(deprecated)
+
+	auth := NewAnnotationSymbol("Auth", true)
+	auth.ArgOrder = append(auth.ArgOrder, "role")
+	auth.Args["role"] = newTypeRef("String")
+	auth.Targets = append(auth.Targets, AnnotationTargetRest, AnnotationTargetService, AnnotationTargetRpc, AnnotationTargetStream, AnnotationTargetChannel)
+	ctx.Add(auth)
+
+	tag := NewAnnotationSymbol("Tag", true)
+	tag.ArgOrder = append(tag.ArgOrder, "name")
+	tag.Args["name"] = newTypeRef("String")
+	tag.Targets = append(tag.Targets, AnnotationTargetRest, AnnotationTargetEvent, AnnotationTargetService, AnnotationTargetRpc, AnnotationTargetStream, AnnotationTargetChannel)
+	ctx.Add(tag)
+
 	is := NewAnnotationSymbol("Is", true)
 	is.Generics = append(is.Generics, &TypeVarNode{Name: &IdentNode{Value: "T"}})
 	is.ArgOrder = append(is.ArgOrder, "constraint", "message")
 	is.Args["constraint"] = newTypeRef("Any")
 	is.Args["message"] = newTypeRef("String")
+	is.IsValidator = true
 	ctx.Add(is)
 
 	min := NewAnnotationSymbol("Min", true)
 	min.ArgOrder = append(min.ArgOrder, "value", "message")
 	min.Args["value"] = newTypeRef("Float")
 	min.Args["message"] = newTypeRef("String")
+	min.IsValidator = true
+	min.FieldTypes = append(min.FieldTypes, newTypeRef("Int"), newTypeRef("Float"))
 	ctx.Add(min)
 
 	max := NewAnnotationSymbol("Max", true)
 	max.ArgOrder = append(max.ArgOrder, "value", "message")
 	max.Args["value"] = newTypeRef("Float")
 	max.Args["message"] = newTypeRef("String")
+	max.IsValidator = true
+	max.FieldTypes = append(max.FieldTypes, newTypeRef("Int"), newTypeRef("Float"))
 	ctx.Add(max)
 
 	length := NewAnnotationSymbol("Length", true)
 	length.ArgOrder = append(length.ArgOrder, "value", "message")
 	length.Args["value"] = newTypeRef("Int")
 	length.Args["message"] = newTypeRef("String")
+	length.IsValidator = true
+	length.FieldTypes = append(length.FieldTypes, newTypeRef("String"), newTypeRef("Array"))
 	ctx.Add(length)
 
 	minLength := NewAnnotationSymbol("MinLength", true)
 	minLength.ArgOrder = append(minLength.ArgOrder, "value", "message")
 	minLength.Args["value"] = newTypeRef("Int")
 	minLength.Args["message"] = newTypeRef("String")
+	minLength.IsValidator = true
+	minLength.FieldTypes = append(minLength.FieldTypes, newTypeRef("String"), newTypeRef("Array"))
 	ctx.Add(minLength)
 
 	maxLength := NewAnnotationSymbol("MaxLength", true)
 	maxLength.ArgOrder = append(maxLength.ArgOrder, "value", "message")
 	maxLength.Args["value"] = newTypeRef("Int")
 	maxLength.Args["message"] = newTypeRef("String")
+	maxLength.IsValidator = true
+	maxLength.FieldTypes = append(maxLength.FieldTypes, newTypeRef("String"), newTypeRef("Array"))
 	ctx.Add(maxLength)
 
 	rangeValue := NewAnnotationSymbol("Range", true)
@@ -738,98 +2811,205 @@ func NewTypeChecker() *TypeChecker {
 	rangeValue.Args["min"] = newTypeRef("Float")
 	rangeValue.Args["max"] = newTypeRef("Float")
 	rangeValue.Args["message"] = newTypeRef("String")
+	rangeValue.IsValidator = true
+	rangeValue.FieldTypes = append(rangeValue.FieldTypes, newTypeRef("Int"), newTypeRef("Float"))
 	ctx.Add(rangeValue)
 
 	matches := NewAnnotationSymbol("Matches", true)
 	matches.ArgOrder = append(matches.ArgOrder, "pattern", "message")
 	matches.Args["pattern"] = newTypeRef("String")
 	matches.Args["message"] = newTypeRef("String")
+	matches.IsValidator = true
+	matches.FieldTypes = append(matches.FieldTypes, newTypeRef("String"))
 	ctx.Add(matches)
 
 	contains := NewAnnotationSymbol("Contains", true)
 	contains.ArgOrder = append(contains.ArgOrder, "value", "message")
 	contains.Args["value"] = newTypeRef("String")
 	contains.Args["message"] = newTypeRef("String")
+	contains.IsValidator = true
+	contains.FieldTypes = append(contains.FieldTypes, newTypeRef("String"))
 	ctx.Add(contains)
 
 	startsWith := NewAnnotationSymbol("StartsWith", true)
 	startsWith.ArgOrder = append(startsWith.ArgOrder, "value", "message")
 	startsWith.Args["value"] = newTypeRef("String")
 	startsWith.Args["message"] = newTypeRef("String")
+	startsWith.IsValidator = true
+	startsWith.FieldTypes = append(startsWith.FieldTypes, newTypeRef("String"))
 	ctx.Add(startsWith)
 
 	endsWith := NewAnnotationSymbol("EndsWith", true)
 	endsWith.ArgOrder = append(endsWith.ArgOrder, "value", "message")
 	endsWith.Args["value"] = newTypeRef("String")
 	endsWith.Args["message"] = newTypeRef("String")
+	endsWith.IsValidator = true
+	endsWith.FieldTypes = append(endsWith.FieldTypes, newTypeRef("String"))
 	ctx.Add(endsWith)
 
 	in := NewAnnotationSymbol("In", true)
 	in.ArgOrder = append(in.ArgOrder, "values", "message")
 	in.Args["values"] = newTypeRef("Array")
 	in.Args["message"] = newTypeRef("String")
+	in.IsValidator = true
 	ctx.Add(in)
 
 	isEmail := NewAnnotationSymbol("IsEmail", true)
 	isEmail.ArgOrder = append(isEmail.ArgOrder, "message")
 	isEmail.Args["message"] = newTypeRef("String")
+	isEmail.IsValidator = true
+	isEmail.FieldTypes = append(isEmail.FieldTypes, newTypeRef("String"))
 	ctx.Add(isEmail)
 
 	isNumber := NewAnnotationSymbol("IsNumber", true)
 	isNumber.ArgOrder = append(isNumber.ArgOrder, "message")
 	isNumber.Args["message"] = newTypeRef("String")
+	isNumber.IsValidator = true
+	isNumber.FieldTypes = append(isNumber.FieldTypes, newTypeRef("Int"), newTypeRef("Float"))
 	ctx.Add(isNumber)
 
 	isURL := NewAnnotationSymbol("IsURL", true)
 	isURL.ArgOrder = append(isURL.ArgOrder, "message")
 	isURL.Args["message"] = newTypeRef("String")
+	isURL.IsValidator = true
+	isURL.FieldTypes = append(isURL.FieldTypes, newTypeRef("String"))
 	ctx.Add(isURL)
 
 	isUUID := NewAnnotationSymbol("IsUUID", true)
 	isUUID.ArgOrder = append(isUUID.ArgOrder, "message")
 	isUUID.Args["message"] = newTypeRef("String")
+	isUUID.IsValidator = true
+	isUUID.FieldTypes = append(isUUID.FieldTypes, newTypeRef("String"))
 	ctx.Add(isUUID)
 
 	isDate := NewAnnotationSymbol("IsDate", true)
 	isDate.ArgOrder = append(isDate.ArgOrder, "message")
 	isDate.Args["message"] = newTypeRef("String")
+	isDate.IsValidator = true
+	isDate.FieldTypes = append(isDate.FieldTypes, newTypeRef("String"))
 	ctx.Add(isDate)
 
 	isDateTime := NewAnnotationSymbol("IsDateTime", true)
 	isDateTime.ArgOrder = append(isDateTime.ArgOrder, "message")
 	isDateTime.Args["message"] = newTypeRef("String")
+	isDateTime.IsValidator = true
+	isDateTime.FieldTypes = append(isDateTime.FieldTypes, newTypeRef("String"))
 	ctx.Add(isDateTime)
 
 	isAlpha := NewAnnotationSymbol("IsAlpha", true)
 	isAlpha.ArgOrder = append(isAlpha.ArgOrder, "message")
 	isAlpha.Args["message"] = newTypeRef("String")
+	isAlpha.IsValidator = true
+	isAlpha.FieldTypes = append(isAlpha.FieldTypes, newTypeRef("String"))
 	ctx.Add(isAlpha)
 
 	isAlnum := NewAnnotationSymbol("IsAlnum", true)
 	isAlnum.ArgOrder = append(isAlnum.ArgOrder, "message")
 	isAlnum.Args["message"] = newTypeRef("String")
+	isAlnum.IsValidator = true
+	isAlnum.FieldTypes = append(isAlnum.FieldTypes, newTypeRef("String"))
 	ctx.Add(isAlnum)
 
 	notNull := NewAnnotationSymbol("NotNull", true)
 	notNull.ArgOrder = append(notNull.ArgOrder, "message")
 	notNull.Args["message"] = newTypeRef("String")
+	notNull.IsValidator = true
 	ctx.Add(notNull)
 
 	isBool := NewAnnotationSymbol("IsBool", true)
 	isBool.ArgOrder = append(isBool.ArgOrder, "message")
 	isBool.Args["message"] = newTypeRef("String")
+	isBool.IsValidator = true
+	isBool.FieldTypes = append(isBool.FieldTypes, newTypeRef("Bool"))
 	ctx.Add(isBool)
 
 	isModel := NewAnnotationSymbol("IsModel", true)
 	isModel.ArgOrder = append(isModel.ArgOrder, "message")
 	isModel.Args["message"] = newTypeRef("String")
+	isModel.IsValidator = true
 	ctx.Add(isModel)
 
+	equalsField := NewAnnotationSymbol("EqualsField", true)
+	equalsField.ArgOrder = append(equalsField.ArgOrder, "field", "other", "message", "key")
+	equalsField.Args["field"] = newTypeRef("String")
+	equalsField.Args["other"] = newTypeRef("String")
+	equalsField.Args["message"] = newTypeRef("String")
+	equalsField.Args["key"] = newTypeRef("String")
+	equalsField.Optional["key"] = true
+	equalsField.Targets = append(equalsField.Targets, AnnotationTargetModel)
+	equalsField.IsValidator = true
+	ctx.Add(equalsField)
+
+	compare := NewAnnotationSymbol("Compare", true)
+	compare.ArgOrder = append(compare.ArgOrder, "field", "operator", "other", "message", "key")
+	compare.Args["field"] = newTypeRef("String")
+	compare.Args["operator"] = newTypeRef("String")
+	compare.Args["other"] = newTypeRef("String")
+	compare.Args["message"] = newTypeRef("String")
+	compare.Args["key"] = newTypeRef("String")
+	compare.Optional["key"] = true
+	compare.Targets = append(compare.Targets, AnnotationTargetModel)
+	compare.IsValidator = true
+	ctx.Add(compare)
+
+	oneOf := NewAnnotationSymbol("OneOf", true)
+	oneOf.ArgOrder = append(oneOf.ArgOrder, "fields", "message", "key")
+	oneOf.Args["fields"] = newTypeRef("Array")
+	oneOf.Args["message"] = newTypeRef("String")
+	oneOf.Args["key"] = newTypeRef("String")
+	oneOf.Optional["key"] = true
+	oneOf.Targets = append(oneOf.Targets, AnnotationTargetModel)
+	oneOf.IsValidator = true
+	ctx.Add(oneOf)
+
+	each := NewAnnotationSymbol("Each", true)
+	each.ArgOrder = append(each.ArgOrder, "validator")
+	each.Args["validator"] = newTypeRef("Annotation")
+	each.IsValidator = true
+	each.FieldTypes = append(each.FieldTypes, newTypeRef("Array"))
+	ctx.Add(each)
+
+	minItems := NewAnnotationSymbol("MinItems", true)
+	minItems.ArgOrder = append(minItems.ArgOrder, "value", "message")
+	minItems.Args["value"] = newTypeRef("Int")
+	minItems.Args["message"] = newTypeRef("String")
+	minItems.IsValidator = true
+	minItems.FieldTypes = append(minItems.FieldTypes, newTypeRef("Array"))
+	ctx.Add(minItems)
+
+	maxItems := NewAnnotationSymbol("MaxItems", true)
+	maxItems.ArgOrder = append(maxItems.ArgOrder, "value", "message")
+	maxItems.Args["value"] = newTypeRef("Int")
+	maxItems.Args["message"] = newTypeRef("String")
+	maxItems.IsValidator = true
+	maxItems.FieldTypes = append(maxItems.FieldTypes, newTypeRef("Array"))
+	ctx.Add(maxItems)
+
+	uniqueItems := NewAnnotationSymbol("UniqueItems", true)
+	uniqueItems.ArgOrder = append(uniqueItems.ArgOrder, "message")
+	uniqueItems.Args["message"] = newTypeRef("String")
+	uniqueItems.IsValidator = true
+	uniqueItems.FieldTypes = append(uniqueItems.FieldTypes, newTypeRef("Array"))
+	ctx.Add(uniqueItems)
+
+	when := NewAnnotationSymbol("When", true)
+	when.ArgOrder = append(when.ArgOrder, "field", "value")
+	when.Args["field"] = newTypeRef("String")
+	when.Args["value"] = newTypeRef("Any")
+	ctx.Add(when)
+
 	nestedValidate := NewAnnotationSymbol("NestedValidate", true)
 	nestedValidate.ArgOrder = append(nestedValidate.ArgOrder, "message")
 	nestedValidate.Args["message"] = newTypeRef("String")
+	nestedValidate.IsValidator = true
 	ctx.Add(nestedValidate)
 
+	for _, sym := range ctx.Symbols {
+		if annotation, ok := sym.(*AnnotationSymbol); ok && len(annotation.Targets) == 0 {
+			annotation.Targets = append(annotation.Targets, AnnotationTargetField)
+		}
+	}
+
 	i := &TypeChecker{
 		Context:  ctx,
 		Warnings: make([]TypeWarning, 0),
@@ -861,6 +3041,9 @@ func isValueAssignableToType(node ASTValueNode, expectedTypeName string) bool {
 	case "Array":
 		_, ok := node.(*ArrayValueNode)
 		return ok
+	case "Annotation":
+		_, ok := node.(*AnnotationValueNode)
+		return ok
 	case "Int":
 		n, ok := node.(*NumberValueNode)
 		if !ok {
@@ -880,6 +3063,97 @@ func isValueAssignableToType(node ASTValueNode, expectedTypeName string) bool {
 	}
 }
 
+func (c *TypeChecker) isValueAssignableToTypeDecl(value ASTValueNode, node *TypeDeclNode) bool {
+	if value == nil || node == nil || node.Name == nil {
+		return false
+	}
+
+	sym := c.Context.GetTypeByName(node.Name.Value)
+	if sym == nil {
+		return false
+	}
+
+	value = c.resolveConstValue(value)
+	if sym.DeclKind == TypeDeclKindEnum {
+		ref, ok := value.(*ReferenceValueNode)
+		if !ok || ref.Name == nil {
+			return false
+		}
+
+		return enumHasMember(sym, ref.Name.Value)
+	}
+
+	if sym.DeclKind != TypeDeclKindBuiltin {
+		return false
+	}
+
+	if node.Name.Value == "Array" {
+		array, ok := value.(*ArrayValueNode)
+		if !ok {
+			return false
+		}
+
+		if len(node.Generics) != 1 {
+			return true
+		}
+
+		for _, item := range array.Values {
+			if !c.isValueAssignableToTypeDecl(item, node.Generics[0]) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return isValueAssignableToType(value, node.Name.Value)
+}
+
+func (c *TypeChecker) enumTypeSymbol(node *TypeDeclNode) *TypeSymbol {
+	if node == nil || node.Name == nil {
+		return nil
+	}
+
+	sym := c.Context.GetTypeByName(node.Name.Value)
+	if sym == nil || sym.DeclKind != TypeDeclKindEnum {
+		return nil
+	}
+
+	return sym
+}
+
+func (c *TypeChecker) isEnumMemberName(name string) bool {
+	for ctx := c.Context; ctx != nil; ctx = ctx.Parent {
+		for _, sym := range ctx.Symbols {
+			typeSym, ok := sym.(*TypeSymbol)
+			if ok && typeSym.DeclKind == TypeDeclKindEnum && enumHasMember(typeSym, name) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func enumHasMember(sym *TypeSymbol, name string) bool {
+	if sym == nil {
+		return false
+	}
+
+	decl, ok := sym.Decl.(*EnumDeclNode)
+	if !ok {
+		return false
+	}
+
+	for _, member := range decl.Members {
+		if member != nil && member.Name != nil && member.Name.Value == name {
+			return true
+		}
+	}
+
+	return false
+}
+
 func isStringType(node *TypeDeclNode) bool {
 	if node == nil || node.Name == nil {
 		return false
@@ -938,6 +3212,154 @@ func (c *TypeChecker) isUserDefinedType(node *TypeDeclNode) bool {
 	return !sym.BuiltIn()
 }
 
+func (c *TypeChecker) CheckValidatorArgs(node *AnnotationNode, field *ModelFieldDeclNode) exception.IException {
+	if sym := c.Context.GetAnnotationByName(node.Name.Value); sym != nil && sym.IsValidator {
+		for _, arg := range node.Args {
+			ref, ok := c.resolveConstValue(arg).(*ReferenceValueNode)
+			if ok && ref.Name != nil && !c.isValueAssignableToTypeDecl(ref, field.Type) {
+				return exception.NewTypeException(
+					fmt.Sprintf("Annotation '%s' value %s does not match field type '%s'", node.Name.Value, FormatValueNode(ref), FormatTypeDecl(field.Type)),
+					ref.Loc,
+				)
+			}
+		}
+	}
+
+	switch node.Name.Value {
+	case "Each":
+		if len(node.Args) == 0 {
+			return nil
+		}
+
+		value, ok := node.Args[0].(*AnnotationValueNode)
+		if !ok || value.Annotation == nil || value.Annotation.Name == nil {
+			return nil
+		}
+
+		inner := value.Annotation
+		if err := c.CheckAnnotation(inner, AnnotationTargetField); err != nil {
+			return err
+		}
+
+		sym := c.Context.GetAnnotationByName(inner.Name.Value)
+		if sym == nil || !sym.IsValidator || inner.Name.Value == "Each" || inner.Name.Value == "NestedValidate" {
+			return exception.NewTypeException(fmt.Sprintf("Annotation '%s' cannot be used inside 'Each'", inner.Name.Value), inner.Loc)
+		}
+
+		element := &ModelFieldDeclNode{Name: field.Name, Type: newTypeRef("Any"), Loc: field.Loc}
+		if len(field.Type.Generics) == 1 {
+			element.Type = field.Type.Generics[0]
+		}
+
+		if len(sym.FieldTypes) > 0 && !c.fieldTypeMatches(element.Type, sym.FieldTypes) {
+			return exception.NewTypeException(
+				fmt.Sprintf("Validator '%s' cannot be used on items of type '%s'", sym.Name, FormatTypeDecl(element.Type)),
+				inner.Loc,
+			)
+		}
+
+		return c.CheckValidatorArgs(inner, element)
+	case "Matches":
+		if len(node.Args) == 0 {
+			return nil
+		}
+
+		pattern, ok := c.resolveConstValue(node.Args[0]).(*StringValueNode)
+		if !ok {
+			return nil
+		}
+
+		compatible, unchecked := RE2CompatiblePattern(pattern.Value)
+		if _, err := regexp.Compile(compatible); err != nil {
+			return exception.NewTypeException(
+				fmt.Sprintf("Annotation 'Matches' pattern is not a valid regular expression: %s", err.Error()),
+				node.Args[0].GetLocation(),
+			)
+		}
+
+		if unchecked {
+			c.AddWarning(
+				"Annotation 'Matches' pattern uses lookaround or backreferences, which could not be checked",
+				node.Args[0].GetLocation(),
+			)
+		}
+	case "Range":
+		if len(node.Args) < 2 {
+			return nil
+		}
+
+		minValue, minOk := c.resolveConstValue(node.Args[0]).(*NumberValueNode)
+		maxValue, maxOk := c.resolveConstValue(node.Args[1]).(*NumberValueNode)
+		if !minOk || !maxOk {
+			return nil
+		}
+
+		min, minErr := strconv.ParseFloat(minValue.Value, 64)
+		max, maxErr := strconv.ParseFloat(maxValue.Value, 64)
+		if minErr == nil && maxErr == nil && min > max {
+			return exception.NewTypeException(
+				fmt.Sprintf("Annotation 'Range' min (%s) must not be greater than max (%s)", minValue.Value, maxValue.Value),
+				node.Loc,
+			)
+		}
+	case "In":
+		if len(node.Args) == 0 {
+			return nil
+		}
+
+		values, ok := c.resolveConstValue(node.Args[0]).(*ArrayValueNode)
+		if !ok {
+			return nil
+		}
+
+		for _, item := range values.Values {
+			if !c.isValueAssignableToTypeDecl(item, field.Type) && !c.isEnumValueLiteral(c.resolveConstValue(item), field.Type) {
+				return exception.NewTypeException(
+					fmt.Sprintf("Annotation 'In' value %s does not match field type '%s'", FormatValueNode(item), FormatTypeDecl(field.Type)),
+					item.GetLocation(),
+				)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c *TypeChecker) fieldTypeMatches(node *TypeDeclNode, candidates []*TypeDeclNode) bool {
+	if node == nil || node.Name == nil {
+		return false
+	}
+
+	if sym := c.Context.GetTypeByName(node.Name.Value); sym != nil && sym.DeclKind == TypeDeclKindGeneric {
+		typeVar, ok := sym.Decl.(*TypeVarNode)
+		if !ok || typeVar.Constraint == nil {
+			return true
+		}
+
+		node = typeVar.Constraint
+	}
+
+	for _, candidate := range candidates {
+		if candidate == nil || candidate.Name == nil {
+			continue
+		}
+
+		if candidate.Name.Value == "Any" {
+			return true
+		}
+
+		if len(candidate.Generics) == 0 && candidate.Name.Value == node.Name.Value {
+			return true
+		}
+
+		if FormatTypeDecl(candidate) == FormatTypeDecl(node) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func hasAnnotationNode(nodes []*AnnotationNode, name string) bool {
 	for _, node := range nodes {
 		if node != nil && node.Name != nil && node.Name.Value == name {
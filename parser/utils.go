@@ -2,9 +2,184 @@ package parser
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 )
 
+func ParsePathParams(path string) ([]string, error) {
+	params := make([]string, 0)
+	for i := 0; i < len(path); i++ {
+		switch path[i] {
+		case '}':
+			return nil, fmt.Errorf("unexpected '}' at position %d", i+1)
+		case '{':
+			end := strings.IndexByte(path[i+1:], '}')
+			if end < 0 {
+				return nil, fmt.Errorf("unclosed '{' at position %d", i+1)
+			}
+
+			name := path[i+1 : i+1+end]
+			if !isPathParamName(name) {
+				return nil, fmt.Errorf("invalid parameter name '%s'", name)
+			}
+
+			params = append(params, name)
+			i += end + 1
+		}
+	}
+
+	return params, nil
+}
+
+func RpcBindingPath(serviceName string, rpcName string) string {
+	if serviceName == "" {
+		return "/rpc/" + rpcName
+	}
+	return "/rpc/" + serviceName + "." + rpcName
+}
+
+func JoinRestPath(basePath string, path string) string {
+	basePath = strings.TrimRight(basePath, "/")
+	if path == "" || path == "/" {
+		if basePath == "" {
+			return "/"
+		}
+		return basePath
+	}
+
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+
+	return basePath + path
+}
+
+func IsHeaderName(name string) bool {
+	if name == "" {
+		return false
+	}
+
+	for _, r := range name {
+		isAlnum := (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9')
+		if !isAlnum && !strings.ContainsRune("!#$%&'*+-.^_`|~", r) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func IsEventVersionTag(value string) bool {
+	if len(value) < 2 || value[0] != 'v' {
+		return false
+	}
+
+	for _, r := range value[1:] {
+		if !IsDigit(r) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func EventVersion(node *EventDeclNode) int {
+	if node == nil {
+		return 1
+	}
+
+	version, ok := node.VersionValue.(*NumberValueNode)
+	if !ok || version == nil {
+		return 1
+	}
+
+	n, err := strconv.Atoi(version.Value)
+	if err != nil {
+		return 1
+	}
+
+	return n
+}
+
+func RE2CompatiblePattern(pattern string) (string, bool) {
+	var sb strings.Builder
+	unchecked := false
+	inClass := false
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		switch {
+		case c == '\\' && i+1 < len(pattern):
+			next := pattern[i+1]
+			switch {
+			case !inClass && next >= '1' && next <= '9':
+				i++
+				for i+1 < len(pattern) && IsDigit(rune(pattern[i+1])) {
+					i++
+				}
+				unchecked = true
+			case !inClass && next == 'k' && i+2 < len(pattern) && pattern[i+2] == '<':
+				end := strings.IndexByte(pattern[i:], '>')
+				if end < 0 {
+					sb.WriteString(pattern[i : i+2])
+					i++
+					continue
+				}
+				i += end
+				unchecked = true
+			case next == 'u' && i+2 < len(pattern) && pattern[i+2] == '{':
+				end := strings.IndexByte(pattern[i:], '}')
+				if end < 0 {
+					sb.WriteString(pattern[i : i+2])
+					i++
+					continue
+				}
+				sb.WriteString("\\x" + pattern[i+2:i+end+1])
+				i += end
+			case next == 'u' && i+5 < len(pattern):
+				sb.WriteString("\\x{" + pattern[i+2:i+6] + "}")
+				i += 5
+			default:
+				sb.WriteString(pattern[i : i+2])
+				i++
+			}
+		case c == '[' && !inClass:
+			inClass = true
+			sb.WriteByte(c)
+		case c == ']' && inClass:
+			inClass = false
+			sb.WriteByte(c)
+		case c == '(' && !inClass && (strings.HasPrefix(pattern[i:], "(?=") || strings.HasPrefix(pattern[i:], "(?!")):
+			sb.WriteString("(?:")
+			i += 2
+			unchecked = true
+		case c == '(' && !inClass && (strings.HasPrefix(pattern[i:], "(?<=") || strings.HasPrefix(pattern[i:], "(?<!")):
+			sb.WriteString("(?:")
+			i += 3
+			unchecked = true
+		default:
+			sb.WriteByte(c)
+		}
+	}
+
+	return sb.String(), unchecked
+}
+
+func isPathParamName(name string) bool {
+	if name == "" {
+		return false
+	}
+
+	for i, r := range name {
+		isLetter := r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+		isDigit := r >= '0' && r <= '9'
+		if !isLetter && (i == 0 || !isDigit) {
+			return false
+		}
+	}
+
+	return true
+}
+
 func PrintTokenList(tokens TokenList) {
 	for _, token := range tokens {
 		fmt.Println(token)
@@ -48,6 +223,32 @@ func FormatTypeDecl(node *TypeDeclNode) string {
 	return fmt.Sprintf("%s<%s>", node.Name.Value, strings.Join(args, ", "))
 }
 
+func IsModelUtility(name string) bool {
+	switch name {
+	case "Partial", "Required", "Pick", "Omit":
+		return true
+	default:
+		return false
+	}
+}
+
+func FormatModelExpr(node *ModelExprNode) string {
+	if node == nil {
+		return "<nil>"
+	}
+
+	if node.Utility == nil {
+		return FormatTypeDecl(node.Ref)
+	}
+
+	args := []string{FormatModelExpr(node.Operand)}
+	for _, key := range node.Keys {
+		args = append(args, FormatValueNode(key))
+	}
+
+	return fmt.Sprintf("%s<%s>", node.Utility.Value, strings.Join(args, ", "))
+}
+
 func FormatTypeVars(vars []*TypeVarNode) string {
 	if len(vars) == 0 {
 		return ""
@@ -55,9 +256,18 @@ func FormatTypeVars(vars []*TypeVarNode) string {
 
 	items := make([]string, 0, len(vars))
 	for _, item := range vars {
-		if item != nil && item.Name != nil {
-			items = append(items, item.Name.Value)
+		if item == nil || item.Name == nil {
+			continue
 		}
+
+		text := item.Name.Value
+		if item.Constraint != nil {
+			text += " extends " + FormatTypeDecl(item.Constraint)
+		}
+		if item.Default != nil {
+			text += " = " + FormatTypeDecl(item.Default)
+		}
+		items = append(items, text)
 	}
 
 	return fmt.Sprintf("<%s>", strings.Join(items, ", "))
@@ -77,6 +287,13 @@ func FormatValueNode(value ASTValueNode) string {
 		return v.Value
 	case *NullValueNode:
 		return "null"
+	case *ReferenceValueNode:
+		if v.Name == nil {
+			return "<unnamed-reference>"
+		}
+		return v.Name.Value
+	case *AnnotationValueNode:
+		return FormatAnnotation(v.Annotation)
 	case *ArrayValueNode:
 		items := make([]string, 0, len(v.Values))
 		for _, item := range v.Values {
@@ -140,6 +357,15 @@ func PrintAST(node any, indent int) {
 			modelName = v.Name.Value
 		}
 		fmt.Printf("%s├── Model: %s%s\n", tab, modelName, FormatTypeVars(v.Generics))
+		if v.Derived != nil {
+			fmt.Printf("%s  ├── = %s\n", tab, FormatModelExpr(v.Derived))
+		}
+		if v.Extends != nil {
+			fmt.Printf("%s  ├── extends: %s\n", tab, FormatTypeDecl(v.Extends))
+		}
+		for _, spread := range v.Spreads {
+			fmt.Printf("%s  ├── ...%s\n", tab, FormatTypeDecl(spread.Type))
+		}
 		PrintAnnotations(v.Annotations, indent+1)
 		for _, field := range v.Fields {
 			PrintAST(field, indent+2)
@@ -151,11 +377,148 @@ func PrintAST(node any, indent int) {
 			restName = v.Name.Value
 		}
 		fmt.Printf("%s├── Rest: %s\n", tab, restName)
+		PrintAnnotations(v.Annotations, indent+1)
 		fmt.Printf("%s  ├── method: %s\n", tab, FormatValueNode(v.MethodValue))
 		fmt.Printf("%s  ├── path: %s\n", tab, FormatValueNode(v.PathValue))
 		fmt.Printf("%s  ├── requestBody: %s\n", tab, FormatTypeDecl(v.RequestBodyType))
 		fmt.Printf("%s  ├── responseBody: %s\n", tab, FormatTypeDecl(v.ResponseBodyType))
-		fmt.Printf("%s  └── queries: %s\n", tab, FormatValueNode(v.QueriesValue))
+		if v.Queries != nil {
+			fmt.Printf("%s  └── queries:\n", tab)
+			for _, field := range v.Queries.Fields {
+				PrintAST(field, indent+2)
+			}
+		} else {
+			fmt.Printf("%s  └── queries: %s\n", tab, FormatValueNode(v.QueriesValue))
+		}
+		if len(v.Responses) > 0 {
+			fmt.Printf("%s  └── responses:\n", tab)
+			for _, response := range v.Responses {
+				fmt.Printf("%s      %s: %s\n", tab, response.Status.Value, FormatTypeDecl(response.Type))
+			}
+		}
+		if v.ErrorsValue != nil {
+			fmt.Printf("%s  └── errors: %s\n", tab, FormatValueNode(v.ErrorsValue))
+		}
+		if v.Params != nil {
+			fmt.Printf("%s  └── params:\n", tab)
+			for _, field := range v.Params.Fields {
+				PrintAST(field, indent+2)
+			}
+		}
+		if v.Headers != nil {
+			fmt.Printf("%s  └── headers:\n", tab)
+			for _, field := range v.Headers.Fields {
+				PrintAST(field, indent+2)
+			}
+		}
+		if v.ResponseHeaders != nil {
+			fmt.Printf("%s  └── responseHeaders:\n", tab)
+			for _, field := range v.ResponseHeaders.Fields {
+				PrintAST(field, indent+2)
+			}
+		}
+
+	case *ServiceDeclNode:
+		serviceName := "<unnamed-service>"
+		if v.Name != nil {
+			serviceName = v.Name.Value
+		}
+		fmt.Printf("%s├── Service: %s\n", tab, serviceName)
+		PrintAnnotations(v.Annotations, indent+1)
+		fmt.Printf("%s  ├── basePath: %s\n", tab, FormatValueNode(v.BasePathValue))
+		if v.Headers != nil {
+			fmt.Printf("%s  ├── headers:\n", tab)
+			for _, field := range v.Headers.Fields {
+				PrintAST(field, indent+2)
+			}
+		}
+		for _, rest := range v.Rests {
+			PrintAST(rest, indent+1)
+		}
+		for _, rpc := range v.Rpcs {
+			PrintAST(rpc, indent+1)
+		}
+
+	case *StreamDeclNode:
+		streamName := "<unnamed-stream>"
+		if v.Name != nil {
+			streamName = v.Name.Value
+		}
+		fmt.Printf("%s├── Stream: %s\n", tab, streamName)
+		PrintAnnotations(v.Annotations, indent+1)
+		fmt.Printf("%s  ├── path: %s\n", tab, FormatValueNode(v.PathValue))
+		fmt.Printf("%s  └── event: %s\n", tab, FormatTypeDecl(v.EventType))
+
+	case *ChannelDeclNode:
+		channelName := "<unnamed-channel>"
+		if v.Name != nil {
+			channelName = v.Name.Value
+		}
+		fmt.Printf("%s├── Channel: %s\n", tab, channelName)
+		PrintAnnotations(v.Annotations, indent+1)
+		fmt.Printf("%s  ├── path: %s\n", tab, FormatValueNode(v.PathValue))
+		clientMessages := make([]string, 0, len(v.ClientMessages))
+		for _, item := range v.ClientMessages {
+			clientMessages = append(clientMessages, FormatTypeDecl(item))
+		}
+		serverMessages := make([]string, 0, len(v.ServerMessages))
+		for _, item := range v.ServerMessages {
+			serverMessages = append(serverMessages, FormatTypeDecl(item))
+		}
+		fmt.Printf("%s  ├── client: %s\n", tab, strings.Join(clientMessages, ", "))
+		fmt.Printf("%s  └── server: %s\n", tab, strings.Join(serverMessages, ", "))
+
+	case *RpcDeclNode:
+		rpcName := "<unnamed-rpc>"
+		if v.Name != nil {
+			rpcName = v.Name.Value
+		}
+		fmt.Printf("%s├── Rpc: %s\n", tab, rpcName)
+		PrintAnnotations(v.Annotations, indent+1)
+		fmt.Printf("%s  ├── request: %s\n", tab, FormatTypeDecl(v.RequestType))
+		fmt.Printf("%s  ├── response: %s\n", tab, FormatTypeDecl(v.ResponseType))
+		throws := make([]string, 0, len(v.Throws))
+		for _, item := range v.Throws {
+			throws = append(throws, item.Value)
+		}
+		fmt.Printf("%s  └── throws: %s\n", tab, strings.Join(throws, ", "))
+
+	case *ConstDeclNode:
+		constName := "<unnamed-const>"
+		if v.Name != nil {
+			constName = v.Name.Value
+		}
+		fmt.Printf("%s├── Const: %s: %s = %s\n", tab, constName, FormatTypeDecl(v.Type), FormatValueNode(v.Value))
+		PrintAnnotations(v.Annotations, indent+1)
+
+	case *AnnotationDeclNode:
+		annotationName := "<unnamed-annotation>"
+		if v.Name != nil {
+			annotationName = v.Name.Value
+		}
+		params := make([]string, 0, len(v.Params))
+		for _, param := range v.Params {
+			params = append(params, param.Name.Value+": "+FormatTypeDecl(param.Type))
+		}
+		targets := make([]string, 0, len(v.Targets))
+		for _, target := range v.Targets {
+			targets = append(targets, target.Value)
+		}
+		keyword := "Annotation"
+		if v.IsValidator {
+			keyword = "Validator"
+		}
+		fmt.Printf("%s├── %s: %s(%s)\n", tab, keyword, annotationName, strings.Join(params, ", "))
+		if len(targets) > 0 {
+			fmt.Printf("%s  └── on: %s\n", tab, strings.Join(targets, ", "))
+		}
+		if len(v.ForTypes) > 0 {
+			forTypes := make([]string, 0, len(v.ForTypes))
+			for _, forType := range v.ForTypes {
+				forTypes = append(forTypes, FormatTypeDecl(forType))
+			}
+			fmt.Printf("%s  └── for: %s\n", tab, strings.Join(forTypes, ", "))
+		}
 
 	case *ModelFieldDeclNode:
 		opt := ""
@@ -166,7 +529,11 @@ func PrintAST(node any, indent int) {
 		if v.Name != nil {
 			fieldName = v.Name.Value
 		}
-		fmt.Printf("%s├── Field: %s%s\n", tab, fieldName, opt)
+		def := ""
+		if v.DefaultValue != nil {
+			def = " = " + FormatValueNode(v.DefaultValue)
+		}
+		fmt.Printf("%s├── Field: %s%s%s\n", tab, fieldName, opt, def)
 		PrintAnnotations(v.Annotations, indent+2)
 		PrintAST(v.Type, indent+3)
 